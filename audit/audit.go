@@ -0,0 +1,246 @@
+// Package audit registra as escritas do Genus em uma tabela de auditoria.
+//
+// Cada Create, Update e Delete feito pelo core.DB gera um Record com a tabela,
+// o ID do registro, a operação, as colunas alteradas (antes e depois) em JSON,
+// o autor e o horário, gravado na mesma transação da escrita.
+//
+// Exemplo:
+//
+//	migrate.AutoMigrate(ctx, sqlDB, dialect, audit.Record{})
+//	audit.Enable(g.DB(), audit.Config{})
+//
+//	ctx = audit.WithActor(ctx, "alice@example.com")
+//	err := g.DB().Update(ctx, &user)
+//
+//	history, err := audit.HistoryOf[User](ctx, g.DB(), user.ID)
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/query"
+)
+
+// TableName é a tabela onde os registros de auditoria são gravados.
+const TableName = "audit_log"
+
+// Values são colunas de um registro e seus valores.
+type Values map[string]interface{}
+
+// Record é uma escrita auditada. Crie a tabela com migrate:
+//
+//	migrate.AutoMigrate(ctx, sqlDB, dialect, audit.Record{})
+type Record struct {
+	ID        int64             `db:"id"`
+	Table     string            `db:"table_name"`
+	RecordID  int64             `db:"record_id"`
+	Operation core.Operation    `db:"operation"`
+	Before    core.JSON[Values] `db:"old_values"`
+	After     core.JSON[Values] `db:"new_values"`
+	Actor     string            `db:"actor"`
+	CreatedAt time.Time         `db:"created_at"`
+}
+
+// TableName implementa core.TableNamer.
+func (Record) TableName() string {
+	return TableName
+}
+
+// Fields são os campos tipados de Record, para filtrar o histórico com Query.
+var Fields = struct {
+	ID        query.Int64Field
+	Table     query.StringField
+	RecordID  query.Int64Field
+	Operation query.Field[core.Operation]
+	Actor     query.StringField
+	CreatedAt query.TimeField
+}{
+	ID:        query.NewInt64Field("id"),
+	Table:     query.NewStringField("table_name"),
+	RecordID:  query.NewInt64Field("record_id"),
+	Operation: query.NewField[core.Operation]("operation"),
+	Actor:     query.NewStringField("actor"),
+	CreatedAt: query.NewTimeField("created_at"),
+}
+
+// Config configura a auditoria.
+type Config struct {
+	// Actor extrai o autor da escrita do contexto. Padrão: ActorFromContext.
+	Actor func(ctx context.Context) string
+	// Tables restringe a auditoria às tabelas informadas. Vazio audita todas.
+	Tables []string
+}
+
+// Auditor grava os registros de auditoria. Implementa core.SnapshotObserver.
+type Auditor struct {
+	config Config
+}
+
+// Enable ativa a auditoria nas escritas de db e das transações abertas a partir dele.
+func Enable(db *core.DB, config Config) *Auditor {
+	if config.Actor == nil {
+		config.Actor = ActorFromContext
+	}
+	auditor := &Auditor{config: config}
+	db.Observe(auditor)
+	return auditor
+}
+
+type actorKey struct{}
+
+// WithActor retorna um contexto com o autor das escritas, lido por ActorFromContext.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext retorna o autor gravado por WithActor, ou "" se não houver.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// NeedsSnapshot implementa core.SnapshotObserver.
+func (a *Auditor) NeedsSnapshot() bool {
+	return true
+}
+
+// ObserveChange implementa core.ChangeObserver: grava o Record da escrita.
+// O INSERT é feito direto no executor da transação, sem passar pelos observers.
+func (a *Auditor) ObserveChange(ctx context.Context, tx *core.DB, change core.Change) error {
+	if !a.audits(change.Table) {
+		return nil
+	}
+
+	before, after := diff(change.Before, change.After)
+	record := Record{
+		Table:     change.Table,
+		RecordID:  change.ID,
+		Operation: change.Op,
+		Before:    core.NewJSON(before),
+		After:     core.NewJSON(after),
+		Actor:     a.config.Actor(ctx),
+		CreatedAt: time.Now(),
+	}
+
+	beforeJSON, err := record.Before.Value()
+	if err != nil {
+		return fmt.Errorf("failed to encode audit values: %w", err)
+	}
+	afterJSON, err := record.After.Value()
+	if err != nil {
+		return fmt.Errorf("failed to encode audit values: %w", err)
+	}
+
+	dialect := tx.Dialect()
+	columns := []string{"table_name", "record_id", "operation", "old_values", "new_values", "actor", "created_at"}
+	args := []interface{}{record.Table, record.RecordID, string(record.Operation), beforeJSON, afterJSON, record.Actor, record.CreatedAt}
+
+	placeholders := make([]string, len(columns))
+	for i := range columns {
+		placeholders[i] = dialect.Placeholder(i + 1)
+	}
+
+	insert := fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s)",
+		dialect.QuoteIdentifier(TableName),
+		strings.Join(columns, ", "),
+		strings.Join(placeholders, ", "),
+	)
+
+	start := time.Now()
+	_, err = tx.Executor().ExecContext(ctx, insert, args...)
+	duration := time.Since(start).Nanoseconds()
+
+	if err != nil {
+		tx.Logger().LogError(insert, args, err)
+		return fmt.Errorf("failed to insert audit record: %w", err)
+	}
+
+	tx.Logger().LogQuery(insert, args, duration)
+	tx.InvalidateTable(TableName)
+	return nil
+}
+
+// audits indica se a tabela é auditada.
+func (a *Auditor) audits(table string) bool {
+	if table == TableName {
+		return false
+	}
+	if len(a.config.Tables) == 0 {
+		return true
+	}
+	for _, t := range a.config.Tables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
+
+// diff retorna apenas as colunas que mudaram entre before e after.
+// No Create (before nil) e no Delete (after nil) retorna todas as colunas.
+func diff(before, after map[string]interface{}) (Values, Values) {
+	if before == nil || after == nil {
+		return Values(before), Values(after)
+	}
+
+	oldValues := Values{}
+	newValues := Values{}
+	for column, newValue := range after {
+		oldValue := before[column]
+		if !equalJSON(oldValue, newValue) {
+			oldValues[column] = oldValue
+			newValues[column] = newValue
+		}
+	}
+	return oldValues, newValues
+}
+
+// equalJSON compara dois valores pela representação JSON, para que tipos
+// diferentes com o mesmo valor (ex: time.Time em fusos diferentes) não
+// apareçam como mudança.
+func equalJSON(a, b interface{}) bool {
+	if ta, ok := a.(time.Time); ok {
+		if tb, ok := b.(time.Time); ok {
+			return ta.Equal(tb)
+		}
+	}
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return string(ja) == string(jb)
+}
+
+// Query retorna um builder sobre a tabela de auditoria, para filtrar o histórico.
+//
+// Exemplo:
+//
+//	records, err := audit.Query(g.DB()).
+//	    Where(audit.Fields.Actor.Eq("alice@example.com")).
+//	    Where(audit.Fields.CreatedAt.WithinLast(24 * time.Hour)).
+//	    Find(ctx)
+func Query(db *core.DB) *query.Builder[Record] {
+	return query.NewBuilder[Record](db.Executor(), db.Dialect(), db.Logger(), TableName)
+}
+
+// History retorna os registros de auditoria de um registro, do mais antigo ao mais recente.
+func History(ctx context.Context, db *core.DB, table string, id int64) ([]Record, error) {
+	return Query(db).
+		Where(Fields.Table.Eq(table)).
+		Where(Fields.RecordID.Eq(id)).
+		OrderBy(Fields.ID.Asc()).
+		Find(ctx)
+}
+
+// HistoryOf retorna o histórico de um registro do modelo T.
+func HistoryOf[T any](ctx context.Context, db *core.DB, id int64) ([]Record, error) {
+	return History(ctx, db, core.TableNameOf[T](), id)
+}
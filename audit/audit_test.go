@@ -0,0 +1,257 @@
+package audit_test
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/audit"
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+	"github.com/GabrielOnRails/genus/migrate"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Account é um modelo auditado.
+type Account struct {
+	core.Model
+	Name    string `db:"name"`
+	Balance int    `db:"balance"`
+}
+
+// Memo é um modelo fora de Config.Tables nos testes de filtro.
+type Memo struct {
+	core.Model
+	Body string `db:"body"`
+}
+
+// silentLogger descarta as queries.
+type silentLogger struct{}
+
+func (silentLogger) LogQuery(query string, args []interface{}, duration int64) {}
+
+func (silentLogger) LogError(query string, args []interface{}, err error) {}
+
+// setupAuditDB cria um banco SQLite em memória com a tabela de auditoria e
+// ativa a auditoria com config.
+func setupAuditDB(t *testing.T, config audit.Config) (*core.DB, *sql.DB) {
+	t.Helper()
+
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if _, err := sqlDB.Exec(`CREATE TABLE account (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT UNIQUE, balance INTEGER, created_at DATETIME, updated_at DATETIME)`); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	if _, err := sqlDB.Exec(`CREATE TABLE memo (id INTEGER PRIMARY KEY AUTOINCREMENT, body TEXT, created_at DATETIME, updated_at DATETIME)`); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	if err := migrate.AutoMigrate(context.Background(), sqlDB, sqlite.New(), audit.Record{}); err != nil {
+		t.Fatalf("Failed to create audit table: %v", err)
+	}
+
+	db := core.NewWithLogger(sqlDB, sqlite.New(), silentLogger{})
+	audit.Enable(db, config)
+	return db, sqlDB
+}
+
+// columns retorna as colunas de values em ordem.
+func columns(values audit.Values) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// count retorna a quantidade de linhas da tabela.
+func count(t *testing.T, sqlDB *sql.DB, table string) int {
+	t.Helper()
+
+	var n int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM ` + table).Scan(&n); err != nil {
+		t.Fatalf("Failed to count %s: %v", table, err)
+	}
+	return n
+}
+
+func TestAuditRecordsDiff(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupAuditDB(t, audit.Config{})
+	ctx := context.Background()
+
+	account := &Account{Name: "alice", Balance: 10}
+	if err := db.Create(ctx, account); err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+	account.Balance = 20
+	if err := db.Update(ctx, account); err != nil {
+		t.Fatalf("Failed to update account: %v", err)
+	}
+	if err := db.Delete(ctx, account); err != nil {
+		t.Fatalf("Failed to delete account: %v", err)
+	}
+
+	history, err := audit.HistoryOf[Account](ctx, db, account.ID)
+	if err != nil {
+		t.Fatalf("HistoryOf failed: %v", err)
+	}
+	if len(history) != 3 {
+		t.Fatalf("Expected 3 records, got %d", len(history))
+	}
+	create, update, del := history[0], history[1], history[2]
+
+	all := []string{"balance", "created_at", "id", "name", "updated_at"}
+
+	// Create grava todas as colunas em After
+	if create.Operation != core.OperationCreate || create.Before.Data != nil {
+		t.Errorf("Unexpected create record: %s, before %v", create.Operation, create.Before.Data)
+	}
+	if got := columns(create.After.Data); !reflect.DeepEqual(got, all) {
+		t.Errorf("Expected create to record all columns, got %v", got)
+	}
+
+	// Update grava só as colunas alteradas: name, id e created_at ficam de fora
+	if update.Operation != core.OperationUpdate {
+		t.Errorf("Expected update record, got %s", update.Operation)
+	}
+	changed := []string{"balance", "updated_at"}
+	if got := columns(update.Before.Data); !reflect.DeepEqual(got, changed) {
+		t.Errorf("Expected update before to have %v, got %v", changed, got)
+	}
+	if got := columns(update.After.Data); !reflect.DeepEqual(got, changed) {
+		t.Errorf("Expected update after to have %v, got %v", changed, got)
+	}
+	if update.Before.Data["balance"] != float64(10) || update.After.Data["balance"] != float64(20) {
+		t.Errorf("Unexpected balance diff: %v -> %v", update.Before.Data["balance"], update.After.Data["balance"])
+	}
+
+	// Delete grava todas as colunas em Before
+	if del.Operation != core.OperationDelete || del.After.Data != nil {
+		t.Errorf("Unexpected delete record: %s, after %v", del.Operation, del.After.Data)
+	}
+	if got := columns(del.Before.Data); !reflect.DeepEqual(got, all) {
+		t.Errorf("Expected delete to record all columns, got %v", got)
+	}
+
+	// History e HistoryOf consultam o mesmo registro
+	byTable, err := audit.History(ctx, db, "account", account.ID)
+	if err != nil {
+		t.Fatalf("History failed: %v", err)
+	}
+	if len(byTable) != len(history) || byTable[0].ID != history[0].ID {
+		t.Errorf("Expected History and HistoryOf to match, got %d and %d records", len(byTable), len(history))
+	}
+}
+
+func TestAuditTablesFilter(t *testing.T) {
+	t.Parallel()
+
+	db, sqlDB := setupAuditDB(t, audit.Config{Tables: []string{"account"}})
+	ctx := context.Background()
+
+	if err := db.Create(ctx, &Memo{Body: "hello"}); err != nil {
+		t.Fatalf("Failed to create memo: %v", err)
+	}
+	if n := count(t, sqlDB, audit.TableName); n != 0 {
+		t.Errorf("Expected memo writes not to be audited, got %d records", n)
+	}
+
+	if err := db.Create(ctx, &Account{Name: "alice"}); err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+	if n := count(t, sqlDB, audit.TableName); n != 1 {
+		t.Errorf("Expected account write to be audited, got %d records", n)
+	}
+}
+
+func TestAuditActor(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupAuditDB(t, audit.Config{})
+	ctx := audit.WithActor(context.Background(), "alice@example.com")
+
+	account := &Account{Name: "alice"}
+	if err := db.Create(ctx, account); err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+
+	// O ator também chega às escritas feitas dentro de transações
+	err := db.WithTx(ctx, func(tx *core.DB) error {
+		account.Balance = 5
+		return tx.Update(ctx, account)
+	})
+	if err != nil {
+		t.Fatalf("Failed to update account: %v", err)
+	}
+
+	records, err := audit.Query(db).Where(audit.Fields.Actor.Eq("alice@example.com")).Find(context.Background())
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(records) != 2 {
+		t.Errorf("Expected 2 records by alice, got %d", len(records))
+	}
+}
+
+func TestAuditCustomActor(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupAuditDB(t, audit.Config{
+		Actor: func(ctx context.Context) string { return "system" },
+	})
+	ctx := context.Background()
+
+	account := &Account{Name: "alice"}
+	if err := db.Create(audit.WithActor(ctx, "ignored"), account); err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+
+	history, err := audit.HistoryOf[Account](ctx, db, account.ID)
+	if err != nil {
+		t.Fatalf("HistoryOf failed: %v", err)
+	}
+	if len(history) != 1 || history[0].Actor != "system" {
+		t.Errorf("Expected one record by system, got %+v", history)
+	}
+}
+
+func TestAuditRollsBackWithWrite(t *testing.T) {
+	t.Parallel()
+
+	db, sqlDB := setupAuditDB(t, audit.Config{})
+	ctx := context.Background()
+
+	if err := db.Create(ctx, &Account{Name: "alice"}); err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+
+	// A escrita falha (nome duplicado): nenhum registro de auditoria é gravado
+	if err := db.Create(ctx, &Account{Name: "alice"}); err == nil {
+		t.Fatal("Expected duplicate account to fail")
+	}
+	if n := count(t, sqlDB, audit.TableName); n != 1 {
+		t.Errorf("Expected only the first create to be audited, got %d records", n)
+	}
+
+	// A auditoria falha: a escrita é desfeita
+	if _, err := sqlDB.Exec(`DROP TABLE ` + audit.TableName); err != nil {
+		t.Fatalf("Failed to drop audit table: %v", err)
+	}
+	err := db.Create(ctx, &Account{Name: "bob"})
+	if err == nil || !strings.Contains(err.Error(), "failed to insert audit record") {
+		t.Fatalf("Expected audit insert error, got %v", err)
+	}
+	if n := count(t, sqlDB, "account"); n != 1 {
+		t.Errorf("Expected create to be rolled back, got %d accounts", n)
+	}
+}
@@ -0,0 +1,152 @@
+// Package cache contém implementações de core.Cache.
+//
+// Exemplo:
+//
+//	g.DB().SetCache(cache.NewLRU(10000))
+//
+//	countries, err := genus.Table[Country](g).Cache(time.Hour).Find(ctx)
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// LRU é um cache em memória com capacidade fixa: ao atingir a capacidade,
+// a entrada usada há mais tempo é descartada. Implementa core.Cache.
+type LRU struct {
+	mu          sync.Mutex
+	capacity    int
+	entries     map[string]*list.Element
+	order       *list.List // mais recente na frente
+	tables      map[string]map[string]struct{}
+	generations map[string]uint64 // invalidações de cada tabela (ver Generation)
+}
+
+// entry é um valor guardado no LRU.
+type entry struct {
+	key       string
+	tables    []string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// NewLRU cria um cache LRU com até capacity entradas.
+func NewLRU(capacity int) *LRU {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &LRU{
+		capacity:    capacity,
+		entries:     make(map[string]*list.Element),
+		order:       list.New(),
+		tables:      make(map[string]map[string]struct{}),
+		generations: make(map[string]uint64),
+	}
+}
+
+// Get implementa core.Cache.
+func (c *LRU) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := element.Value.(*entry)
+	if time.Now().After(e.expiresAt) {
+		c.remove(element)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return e.value, true
+}
+
+// Generation implementa core.Cache. A geração é a soma das invalidações das
+// tabelas, que só cresce: qualquer InvalidateTable de uma delas a altera.
+func (c *LRU) Generation(tables []string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation(tables)
+}
+
+// generation calcula a geração das tabelas. Deve ser chamado com mu bloqueado.
+func (c *LRU) generation(tables []string) uint64 {
+	var sum uint64
+	for _, table := range tables {
+		sum += c.generations[table]
+	}
+	return sum
+}
+
+// Set implementa core.Cache.
+func (c *LRU) Set(key string, tables []string, value interface{}, ttl time.Duration, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generation(tables) != generation {
+		return
+	}
+
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+
+	element := c.order.PushFront(&entry{
+		key:       key,
+		tables:    tables,
+		value:     value,
+		expiresAt: time.Now().Add(ttl),
+	})
+	c.entries[key] = element
+
+	for _, table := range tables {
+		keys, ok := c.tables[table]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.tables[table] = keys
+		}
+		keys[key] = struct{}{}
+	}
+
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+// InvalidateTable implementa core.Cache.
+func (c *LRU) InvalidateTable(table string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[table]++
+	for key := range c.tables[table] {
+		c.remove(c.entries[key])
+	}
+}
+
+// Len retorna o número de entradas no cache (incluindo as expiradas ainda não removidas).
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// remove remove a entrada do cache. Deve ser chamado com mu bloqueado.
+func (c *LRU) remove(element *list.Element) {
+	e := element.Value.(*entry)
+	c.order.Remove(element)
+	delete(c.entries, e.key)
+
+	for _, table := range e.tables {
+		keys := c.tables[table]
+		delete(keys, e.key)
+		if len(keys) == 0 {
+			delete(c.tables, table)
+		}
+	}
+}
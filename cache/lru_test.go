@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUInvalidatesEveryTable(t *testing.T) {
+	c := NewLRU(10)
+	tables := []string{"orders", "users"}
+	c.Set("key", tables, 1, time.Minute, c.Generation(tables))
+
+	if _, ok := c.Get("key"); !ok {
+		t.Fatal("Expected entry to be cached")
+	}
+
+	c.InvalidateTable("users")
+	if _, ok := c.Get("key"); ok {
+		t.Error("Expected entry to be invalidated by a write to a joined table")
+	}
+	if c.Len() != 0 {
+		t.Errorf("Expected empty cache, got %d entries", c.Len())
+	}
+}
+
+func TestLRUDropsStaleSet(t *testing.T) {
+	c := NewLRU(10)
+	tables := []string{"users"}
+
+	// A query lê a geração, uma escrita invalida a tabela e só então a
+	// query tenta guardar o resultado, que pode ser anterior à escrita
+	generation := c.Generation(tables)
+	c.InvalidateTable("users")
+	c.Set("key", tables, 1, time.Minute, generation)
+
+	if _, ok := c.Get("key"); ok {
+		t.Error("Expected stale result to be dropped")
+	}
+
+	c.Set("key", tables, 2, time.Minute, c.Generation(tables))
+	if value, ok := c.Get("key"); !ok || value != 2 {
+		t.Errorf("Expected fresh result to be cached, got %v", value)
+	}
+
+	// Escritas em outras tabelas não afetam a geração
+	generation = c.Generation(tables)
+	c.InvalidateTable("orders")
+	if c.Generation(tables) != generation {
+		t.Error("Expected generation to ignore other tables")
+	}
+}
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRU(2)
+	tables := []string{"users"}
+	c.Set("a", tables, 1, time.Minute, c.Generation(tables))
+	c.Set("b", tables, 2, time.Minute, c.Generation(tables))
+	c.Get("a")
+	c.Set("c", tables, 3, time.Minute, c.Generation(tables))
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("Expected least recently used entry to be evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Error("Expected recently used entry to be kept")
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"go/token"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
+	"unicode"
 )
 
 // Config contém as configurações para o gerador de código.
@@ -36,15 +38,22 @@ type FieldInfo struct {
 	Name       string // Nome do campo Go (ex: "Name")
 	ColumnName string // Nome da coluna no DB (ex: "name")
 	Type       string // Tipo Go (ex: "string", "int64", "core.Optional[string]")
-	FieldType  string // Tipo do campo query (ex: "StringField", "OptionalStringField")
+	FieldType  string // Tipo do campo query (ex: "StringField", "OptionalStringField", "OrderedField[uint]")
 }
 
 // StructInfo contém informações sobre uma struct.
 type StructInfo struct {
-	Name       string      // Nome da struct (ex: "User")
-	Fields     []FieldInfo // Campos da struct
-	Package    string      // Nome do pacote
-	ImportCore bool        // Se precisa importar o pacote core
+	Name    string      // Nome da struct (ex: "User")
+	Fields  []FieldInfo // Campos da struct
+	Package string      // Nome do pacote
+	Imports []string    // Imports usados pelos tipos dos campos (ex: `"github.com/google/uuid"`)
+}
+
+// orderedTypes são os tipos Go que satisfazem cmp.Ordered e usam query.OrderedField.
+var orderedTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"byte": true, "rune": true, "float32": true, "float64": true, "string": true,
 }
 
 // GenerateFromPath gera código a partir de um caminho (arquivo ou diretório).
@@ -58,16 +67,43 @@ func (g *Generator) GenerateFromPath(path string) error {
 		return g.generateFromDir(path)
 	}
 
-	return g.generateFromFile(path)
+	namedTypes, err := g.packageNamedTypes(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+
+	return g.generateFromFile(path, namedTypes)
 }
 
 // generateFromDir gera código para todos os arquivos Go em um diretório.
 func (g *Generator) generateFromDir(dir string) error {
+	files, err := sourceFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	namedTypes, err := g.packageNamedTypes(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range files {
+		if err := g.generateFromFile(path, namedTypes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sourceFiles retorna os arquivos Go de um diretório, ignorando arquivos gerados e de teste.
+func sourceFiles(dir string) ([]string, error) {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
-		return fmt.Errorf("failed to read directory: %w", err)
+		return nil, fmt.Errorf("failed to read directory: %w", err)
 	}
 
+	var files []string
 	for _, entry := range entries {
 		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
 			continue
@@ -78,24 +114,44 @@ func (g *Generator) generateFromDir(dir string) error {
 			continue
 		}
 
-		path := filepath.Join(dir, entry.Name())
-		if err := g.generateFromFile(path); err != nil {
-			return err
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+
+	return files, nil
+}
+
+// packageNamedTypes mapeia os tipos declarados em todos os arquivos do pacote
+// para seu tipo subjacente, para que um modelo possa usar tipos declarados
+// em outro arquivo (ex: "type UserID int64" em ids.go).
+func (g *Generator) packageNamedTypes(dir string) (map[string]string, error) {
+	files, err := sourceFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	namedTypes := make(map[string]string)
+	fset := token.NewFileSet()
+	for _, path := range files {
+		node, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse file: %w", err)
 		}
+		g.extractNamedTypes(node, namedTypes)
 	}
 
-	return nil
+	return namedTypes, nil
 }
 
 // generateFromFile gera código a partir de um arquivo Go.
-func (g *Generator) generateFromFile(filename string) error {
+// namedTypes são os tipos declarados no pacote (ver packageNamedTypes).
+func (g *Generator) generateFromFile(filename string, namedTypes map[string]string) error {
 	fset := token.NewFileSet()
 	node, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
 	if err != nil {
 		return fmt.Errorf("failed to parse file: %w", err)
 	}
 
-	structs := g.extractStructs(node)
+	structs := g.extractStructs(node, namedTypes)
 	if len(structs) == 0 {
 		return nil
 	}
@@ -119,8 +175,9 @@ func (g *Generator) generateFromFile(filename string) error {
 }
 
 // extractStructs extrai informações de structs do AST.
-func (g *Generator) extractStructs(node *ast.File) []StructInfo {
+func (g *Generator) extractStructs(node *ast.File, namedTypes map[string]string) []StructInfo {
 	var structs []StructInfo
+	imports := fileImports(node)
 
 	ast.Inspect(node, func(n ast.Node) bool {
 		typeSpec, ok := n.(*ast.TypeSpec)
@@ -158,7 +215,7 @@ func (g *Generator) extractStructs(node *ast.File) []StructInfo {
 			// Extrai informações do campo
 			for _, name := range field.Names {
 				fieldType := g.getFieldType(field.Type)
-				queryFieldType := g.getQueryFieldType(fieldType)
+				queryFieldType := g.getQueryFieldType(fieldType, namedTypes)
 
 				fieldInfo := FieldInfo{
 					Name:       name.Name,
@@ -168,16 +225,12 @@ func (g *Generator) extractStructs(node *ast.File) []StructInfo {
 				}
 
 				structInfo.Fields = append(structInfo.Fields, fieldInfo)
-
-				// Marca se precisa importar core
-				if strings.Contains(fieldType, "Optional") {
-					structInfo.ImportCore = true
-				}
 			}
 		}
 
 		// Só adiciona struct se tiver campos
 		if len(structInfo.Fields) > 0 {
+			structInfo.Imports = fieldImports(structInfo.Fields, imports)
 			structs = append(structs, structInfo)
 		}
 
@@ -187,6 +240,101 @@ func (g *Generator) extractStructs(node *ast.File) []StructInfo {
 	return structs
 }
 
+// extractNamedTypes adiciona a namedTypes os tipos declarados no arquivo e seu tipo subjacente.
+// Ex: "type UserID int64" -> {"UserID": "int64"}
+func (g *Generator) extractNamedTypes(node *ast.File, namedTypes map[string]string) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		typeSpec, ok := n.(*ast.TypeSpec)
+		if !ok || typeSpec.TypeParams != nil {
+			return true
+		}
+
+		if _, isStruct := typeSpec.Type.(*ast.StructType); !isStruct {
+			namedTypes[typeSpec.Name.Name] = g.getFieldType(typeSpec.Type)
+		}
+		return true
+	})
+}
+
+// fileImports mapeia o nome de cada pacote importado pelo arquivo para sua
+// declaração de import. Sem alias, o nome é o último elemento do caminho,
+// ignorando sufixos de versão (ex: "gopkg.in/yaml.v3" -> "yaml").
+func fileImports(node *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, spec := range node.Imports {
+		path := strings.Trim(spec.Path.Value, `"`)
+		if spec.Name != nil {
+			if spec.Name.Name != "_" && spec.Name.Name != "." {
+				imports[spec.Name.Name] = spec.Name.Name + " " + spec.Path.Value
+			}
+			continue
+		}
+
+		name := path[strings.LastIndex(path, "/")+1:]
+		if versioned := strings.LastIndex(name, ".v"); versioned > 0 {
+			name = name[:versioned]
+		}
+		if isMajorVersion(name) && strings.Count(path, "/") > 0 {
+			parent := strings.TrimSuffix(path, "/"+name)
+			name = parent[strings.LastIndex(parent, "/")+1:]
+		}
+		imports[name] = spec.Path.Value
+	}
+	return imports
+}
+
+// isMajorVersion indica se o elemento de caminho é um sufixo de versão (ex: "v2").
+func isMajorVersion(elem string) bool {
+	if len(elem) < 2 || elem[0] != 'v' {
+		return false
+	}
+	for _, r := range elem[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldImports retorna os imports necessários para os tipos de campo gerados,
+// além de query (ex: query.Field[uuid.UUID] precisa do import de uuid).
+func fieldImports(fields []FieldInfo, imports map[string]string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		for _, qualifier := range typeQualifiers(field.FieldType) {
+			spec, ok := imports[qualifier]
+			if !ok || qualifier == "query" || seen[qualifier] {
+				continue
+			}
+			seen[qualifier] = true
+			result = append(result, spec)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// typeQualifiers retorna os nomes de pacote usados em um tipo.
+// Ex: "query.OptionalField[uuid.UUID]" -> ["query", "uuid"]
+func typeQualifiers(goType string) []string {
+	var qualifiers []string
+	start := -1
+	for i, r := range goType {
+		isIdent := r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case isIdent && start == -1:
+			start = i
+		case r == '.' && start != -1:
+			qualifiers = append(qualifiers, goType[start:i])
+			start = -1
+		case !isIdent:
+			start = -1
+		}
+	}
+	return qualifiers
+}
+
 // getFieldType extrai o tipo Go de um campo.
 func (g *Generator) getFieldType(expr ast.Expr) string {
 	switch t := expr.(type) {
@@ -199,13 +347,20 @@ func (g *Generator) getFieldType(expr ast.Expr) string {
 		return fmt.Sprintf("%s[%s]", g.getFieldType(t.X), g.getFieldType(t.Index))
 	case *ast.StarExpr:
 		return "*" + g.getFieldType(t.X)
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "unknown"
+		}
+		return "[]" + g.getFieldType(t.Elt)
 	default:
 		return "unknown"
 	}
 }
 
 // getQueryFieldType mapeia tipos Go para tipos de campo query.
-func (g *Generator) getQueryFieldType(goType string) string {
+// Tipos sem campo nomeado (ex: uint, []byte, type UserID int64) usam os campos
+// genéricos query.Field[T] e query.OrderedField[T].
+func (g *Generator) getQueryFieldType(goType string, namedTypes map[string]string) string {
 	// Remove ponteiros
 	goType = strings.TrimPrefix(goType, "*")
 
@@ -221,11 +376,30 @@ func (g *Generator) getQueryFieldType(goType string) string {
 		return "query.BoolField"
 	case "float64":
 		return "query.Float64Field"
+	case "time.Time":
+		return "query.TimeField"
+	}
+
+	// Mapeia colunas JSON (inclusive nullable)
+	if isJSONType(goType) {
+		return "query.JSONField"
+	}
+
+	// Mapeia arrays do PostgreSQL
+	if strings.HasPrefix(goType, "Array[") || strings.HasPrefix(goType, "core.Array[") {
+		elemType := extractGenericType(goType)
+		if genericFieldType(elemType, namedTypes) != "" {
+			return fmt.Sprintf("query.ArrayField[%s]", elemType)
+		}
 	}
 
 	// Mapeia tipos Optional
 	if strings.HasPrefix(goType, "Optional[") || strings.HasPrefix(goType, "core.Optional[") {
 		innerType := extractGenericType(goType)
+		if isJSONType(innerType) {
+			return "query.JSONField"
+		}
+
 		switch innerType {
 		case "string":
 			return "query.OptionalStringField"
@@ -237,13 +411,61 @@ func (g *Generator) getQueryFieldType(goType string) string {
 			return "query.OptionalBoolField"
 		case "float64":
 			return "query.OptionalFloat64Field"
+		case "time.Time":
+			return "query.OptionalTimeField"
+		}
+
+		if generic := genericFieldType(innerType, namedTypes); generic != "" {
+			return "query.Optional" + generic
 		}
+		if innerType != "" && innerType != "unknown" {
+			return fmt.Sprintf("query.OptionalField[%s]", innerType)
+		}
+	}
+
+	if generic := genericFieldType(goType, namedTypes); generic != "" {
+		return "query." + generic
+	}
+
+	// Demais tipos (structs, tipos de outros pacotes como uuid.UUID) usam Field[T],
+	// que oferece igualdade e IN com o próprio tipo
+	if goType != "unknown" {
+		return fmt.Sprintf("query.Field[%s]", goType)
 	}
 
 	// Fallback para StringField
 	return "query.StringField"
 }
 
+// isJSONType verifica se o tipo é core.JSON[T].
+func isJSONType(goType string) bool {
+	return strings.HasPrefix(goType, "JSON[") || strings.HasPrefix(goType, "core.JSON[")
+}
+
+// genericFieldType retorna "OrderedField[T]" ou "Field[T]" para tipos que podem ser
+// referenciados no arquivo gerado sem imports extras: tipos builtin, []byte e
+// tipos declarados no próprio pacote. Retorna "" caso contrário.
+func genericFieldType(goType string, namedTypes map[string]string) string {
+	if goType == "" || strings.Contains(goType, ".") {
+		return ""
+	}
+
+	underlying := goType
+	if named, ok := namedTypes[goType]; ok {
+		underlying = named
+	}
+
+	if orderedTypes[underlying] {
+		return fmt.Sprintf("OrderedField[%s]", goType)
+	}
+
+	if underlying == "bool" || underlying == "[]byte" {
+		return fmt.Sprintf("Field[%s]", goType)
+	}
+
+	return ""
+}
+
 // generateFieldsFile gera o arquivo de campos para uma struct.
 func (g *Generator) generateFieldsFile(structInfo StructInfo, sourceFile string) error {
 	// Gera o código
@@ -309,6 +531,10 @@ func extractDBTag(tag string) string {
 		if strings.HasPrefix(part, "db:") {
 			value := strings.TrimPrefix(part, "db:")
 			value = strings.Trim(value, `"`)
+			// Remove opções como ",readonly" e ",omitempty"
+			if i := strings.Index(value, ","); i != -1 {
+				value = value[:i]
+			}
 			return value
 		}
 	}
@@ -317,10 +543,10 @@ func extractDBTag(tag string) string {
 }
 
 // extractGenericType extrai o tipo interno de um tipo genérico.
-// Ex: "Optional[string]" -> "string"
+// Ex: "Optional[string]" -> "string", "Optional[core.JSON[Prefs]]" -> "core.JSON[Prefs]"
 func extractGenericType(genericType string) string {
 	start := strings.Index(genericType, "[")
-	end := strings.Index(genericType, "]")
+	end := strings.LastIndex(genericType, "]")
 
 	if start == -1 || end == -1 || start >= end {
 		return ""
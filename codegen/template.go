@@ -1,5 +1,7 @@
 package codegen
 
+import "strings"
+
 // fieldsTemplate é o template para gerar arquivos de campos tipados.
 const fieldsTemplate = `// Code generated by genus generate. DO NOT EDIT.
 
@@ -7,8 +9,8 @@ package {{.Package}}
 
 import (
 	"github.com/GabrielOnRails/genus/query"
-	{{- if .ImportCore}}
-	"github.com/GabrielOnRails/genus/core"
+	{{- range .Imports}}
+	{{.}}
 	{{- end}}
 )
 
@@ -31,34 +33,11 @@ var {{.Name}}Fields = struct {
 `
 
 // fieldConstructor retorna o construtor apropriado para um tipo de campo.
+// Ex: "query.StringField" -> "query.NewStringField",
+// "query.OrderedField[uint]" -> "query.NewOrderedField[uint]"
 func fieldConstructor(fieldType string) string {
-	switch fieldType {
-	case "query.StringField":
-		return "query.NewStringField"
-	case "query.IntField":
-		return "query.NewIntField"
-	case "query.Int64Field":
-		return "query.NewInt64Field"
-	case "query.BoolField":
-		return "query.NewBoolField"
-	case "query.Float64Field":
-		return "query.NewFloat64Field"
-	case "query.OptionalStringField":
-		return "query.NewOptionalStringField"
-	case "query.OptionalIntField":
-		return "query.NewOptionalIntField"
-	case "query.OptionalInt64Field":
-		return "query.NewOptionalInt64Field"
-	case "query.OptionalBoolField":
-		return "query.NewOptionalBoolField"
-	case "query.OptionalFloat64Field":
-		return "query.NewOptionalFloat64Field"
-	default:
+	if !strings.HasPrefix(fieldType, "query.") {
 		return "query.NewStringField"
 	}
-}
-
-// Registra a função helper no template
-func init() {
-	// Esta função será usada no template acima via pipe: {{.FieldType | fieldConstructor}}
+	return "query.New" + strings.TrimPrefix(fieldType, "query.")
 }
@@ -0,0 +1,94 @@
+package core
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/lib/pq"
+)
+
+// Array representa uma coluna array do PostgreSQL (ex: TEXT[], BIGINT[]).
+// Usa a codificação de arrays do driver pq; como é um slice, pode ser usado
+// diretamente com len, range e append.
+//
+// Exemplo de uso:
+//
+//	type Post struct {
+//	    core.Model
+//	    Tags core.Array[string] `db:"tags"` // TEXT[]
+//	}
+//
+//	post.Tags = core.Array[string]{"go", "orm"}
+//
+// Elementos de tipos derivados (ex: type Role string) são convertidos para o
+// tipo base; outros tipos de elemento devem implementar sql.Scanner e driver.Valuer.
+type Array[T any] []T
+
+// --- Suporte a database/sql ---
+
+// Scan implementa sql.Scanner. NULL resulta em um Array nil.
+func (a *Array[T]) Scan(value any) error {
+	if value == nil {
+		*a = nil
+		return nil
+	}
+
+	elemType := reflect.TypeOf((*T)(nil)).Elem()
+	baseType := arrayBaseType(elemType)
+	if baseType == nil {
+		return pq.GenericArray{A: (*[]T)(a)}.Scan(value)
+	}
+
+	// Lê para o slice do tipo base (ex: []int64) e converte cada elemento para T
+	base := reflect.New(reflect.SliceOf(baseType))
+	if err := pq.Array(base.Interface()).Scan(value); err != nil {
+		return err
+	}
+
+	elems := base.Elem()
+	result := make(Array[T], elems.Len())
+	for i := range result {
+		reflect.ValueOf(&result[i]).Elem().Set(elems.Index(i).Convert(elemType))
+	}
+
+	*a = result
+	return nil
+}
+
+// Value implementa driver.Valuer. Um Array nil é gravado como array vazio ({}).
+func (a Array[T]) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+
+	elemType := reflect.TypeOf((*T)(nil)).Elem()
+	baseType := arrayBaseType(elemType)
+	if baseType == nil {
+		return pq.GenericArray{A: []T(a)}.Value()
+	}
+
+	base := reflect.MakeSlice(reflect.SliceOf(baseType), len(a), len(a))
+	for i, v := range a {
+		base.Index(i).Set(reflect.ValueOf(v).Convert(baseType))
+	}
+
+	return pq.Array(base.Interface()).Value()
+}
+
+// arrayBaseType retorna o tipo de elemento com suporte nativo no pq para o
+// kind de t, ou nil se o elemento deve implementar sql.Scanner/driver.Valuer.
+func arrayBaseType(t reflect.Type) reflect.Type {
+	switch t.Kind() {
+	case reflect.String:
+		return reflect.TypeOf("")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return reflect.TypeOf(int64(0))
+	case reflect.Float32, reflect.Float64:
+		return reflect.TypeOf(float64(0))
+	case reflect.Bool:
+		return reflect.TypeOf(false)
+	default:
+		return nil
+	}
+}
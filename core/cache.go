@@ -0,0 +1,47 @@
+package core
+
+import "time"
+
+// Cache guarda resultados de queries (ver query.Builder.Cache).
+// Cada entrada pertence às tabelas lidas pela query, para que escritas em
+// qualquer uma delas invalidem a entrada. Os valores são os próprios
+// resultados Go ([]T, int64). Implementações devem ser seguras para uso concorrente.
+type Cache interface {
+	// Get retorna o valor da chave, se presente e não expirado.
+	Get(key string) (value interface{}, ok bool)
+	// Generation retorna a geração atual das tabelas: um valor que muda
+	// sempre que InvalidateTable é chamado para qualquer uma delas.
+	// A query lê a geração antes de executar e a repassa a Set.
+	Generation(tables []string) uint64
+	// Set guarda o valor da chave, associado às tabelas, por ttl.
+	// Se a geração das tabelas mudou desde generation, o valor é descartado:
+	// a query pode ter lido dados anteriores a uma escrita já invalidada.
+	Set(key string, tables []string, value interface{}, ttl time.Duration, generation uint64)
+	// InvalidateTable remove todas as entradas da tabela e avança sua geração.
+	InvalidateTable(table string)
+}
+
+// SetCache define o cache usado pelas queries com Cache(ttl) e invalidado
+// pelas escritas deste DB. nil desativa o cache.
+func (db *DB) SetCache(cache Cache) {
+	db.cache = cache
+}
+
+// Cache retorna o cache atual, ou nil.
+func (db *DB) Cache() Cache {
+	return db.cache
+}
+
+// InvalidateTable remove do cache as entradas da tabela. Create, Update e
+// Delete já invalidam automaticamente; use após escritas com SQL cru.
+// Dentro de uma transação, a tabela é invalidada de novo após o commit,
+// descartando resultados lidos por outras conexões antes do commit.
+func (db *DB) InvalidateTable(table string) {
+	if db.cache == nil {
+		return
+	}
+	db.cache.InvalidateTable(table)
+	if db.txTables != nil {
+		*db.txTables = append(*db.txTables, table)
+	}
+}
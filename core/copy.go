@@ -0,0 +1,86 @@
+package core
+
+import "reflect"
+
+// deepCopier é implementado por tipos com campos não exportados que
+// DeepCopy não consegue copiar por reflexão (ex: Optional).
+type deepCopier interface {
+	deepCopy() interface{}
+}
+
+// DeepCopy retorna uma cópia de v que não compartilha memória com o original:
+// slices, maps, ponteiros e interfaces são copiados recursivamente, inclusive
+// dentro de Optional, JSON e Array. Campos não exportados de outras structs
+// (ex: time.Time) são copiados por valor.
+//
+// Usado pelo cache de resultados, para que alterar um resultado não altere o cache.
+func DeepCopy[T any](v T) T {
+	return deepCopyValue(reflect.ValueOf(&v).Elem()).Interface().(T)
+}
+
+// deepCopyValue copia v recursivamente.
+func deepCopyValue(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Struct && v.CanInterface() {
+		if copier, ok := v.Interface().(deepCopier); ok {
+			return reflect.ValueOf(copier.deepCopy())
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+		return copied
+
+	case reflect.Array:
+		copied := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+		return copied
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+		}
+		return copied
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Elem().Type())
+		copied.Elem().Set(deepCopyValue(v.Elem()))
+		return copied
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(deepCopyValue(v.Elem()))
+		return copied
+
+	case reflect.Struct:
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if field := copied.Field(i); field.CanSet() {
+				field.Set(deepCopyValue(v.Field(i)))
+			}
+		}
+		return copied
+	}
+
+	return v
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeepCopy(t *testing.T) {
+	type Prefs struct {
+		Tags map[string]string
+	}
+	type Row struct {
+		Model
+		Labels   Array[string]
+		Prefs    JSON[Prefs]
+		Extra    Optional[JSON[map[string]int]]
+		Metadata interface{}
+	}
+
+	original := []Row{{
+		Model:    Model{ID: 1, CreatedAt: time.Now()},
+		Labels:   Array[string]{"a"},
+		Prefs:    NewJSON(Prefs{Tags: map[string]string{"theme": "dark"}}),
+		Extra:    Some(NewJSON(map[string]int{"n": 1})),
+		Metadata: map[string]interface{}{"k": []int{1}},
+	}}
+
+	copied := DeepCopy(original)
+
+	copied[0].ID = 2
+	copied[0].Labels[0] = "b"
+	copied[0].Prefs.Data.Tags["theme"] = "light"
+	copied[0].Extra.Get().Data["n"] = 2
+	copied[0].Metadata.(map[string]interface{})["k"].([]int)[0] = 2
+
+	row := original[0]
+	if row.ID != 1 {
+		t.Errorf("Expected ID 1, got %d", row.ID)
+	}
+	if row.Labels[0] != "a" {
+		t.Errorf("Expected Array to be copied, got %v", row.Labels)
+	}
+	if row.Prefs.Data.Tags["theme"] != "dark" {
+		t.Errorf("Expected JSON map to be copied, got %v", row.Prefs.Data.Tags)
+	}
+	if row.Extra.Get().Data["n"] != 1 {
+		t.Errorf("Expected Optional value to be copied, got %v", row.Extra.Get().Data)
+	}
+	if row.Metadata.(map[string]interface{})["k"].([]int)[0] != 1 {
+		t.Errorf("Expected interface value to be copied, got %v", row.Metadata)
+	}
+	if !row.CreatedAt.Equal(copied[0].CreatedAt) {
+		t.Error("Expected time.Time to be preserved")
+	}
+}
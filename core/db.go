@@ -11,9 +11,17 @@ import (
 
 // DB é a estrutura principal do ORM. Usa generics para type-safety.
 type DB struct {
-	executor Executor
-	dialect  Dialect
-	logger   Logger
+	executor  Executor
+	dialect   Dialect
+	logger    Logger
+	observers []ChangeObserver
+	cache     Cache
+
+	// tenantResolver ativa o modo multi-tenant (ver SetTenantResolver)
+	tenantResolver TenantResolver
+
+	// txTables são as tabelas invalidadas no cache durante a transação
+	txTables *[]string
 }
 
 // New cria uma nova instância do Genus DB com logging padrão.
@@ -47,9 +55,13 @@ func (db *DB) WithTx(ctx context.Context, fn func(*DB) error) error {
 	}
 
 	txDB := &DB{
-		executor: tx,
-		dialect:  db.dialect,
-		logger:   db.logger, // propaga o logger para a transação
+		executor:       tx,
+		dialect:        db.dialect,
+		logger:         db.logger,         // propaga o logger para a transação
+		observers:      db.observers,      // e os observers
+		tenantResolver: db.tenantResolver, // e o modo multi-tenant
+		cache:          db.cache,
+		txTables:       &[]string{},
 	}
 
 	if err := fn(txDB); err != nil {
@@ -63,6 +75,10 @@ func (db *DB) WithTx(ctx context.Context, fn func(*DB) error) error {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	for _, table := range *txDB.txTables {
+		db.InvalidateTable(table)
+	}
+
 	return nil
 }
 
@@ -98,6 +114,11 @@ func (db *DB) Create(ctx context.Context, model interface{}) error {
 
 	tableName := getTableName(model)
 
+	// Preenche a coluna do tenant no modo multi-tenant
+	if _, err := db.modelTenant(ctx, model); err != nil {
+		return err
+	}
+
 	// Preenche timestamps se for Model
 	setTimestamps(model)
 
@@ -119,34 +140,40 @@ func (db *DB) Create(ctx context.Context, model interface{}) error {
 		strings.Join(placeholders, ", "),
 	)
 
-	// Executa e pega o ID retornado
-	var id int64
-	start := time.Now()
-	err = db.executor.QueryRowContext(ctx, query, values...).Scan(&id)
-	duration := time.Since(start).Nanoseconds()
+	return db.observe(ctx, OperationCreate, model, func(tx *DB) error {
+		// Executa e pega o ID retornado
+		var id int64
+		start := time.Now()
+		err := tx.executor.QueryRowContext(ctx, query, values...).Scan(&id)
+		duration := time.Since(start).Nanoseconds()
 
-	if err != nil {
-		db.logger.LogError(query, values, err)
-		return fmt.Errorf("failed to insert: %w", err)
-	}
+		if err != nil {
+			tx.logger.LogError(query, values, err)
+			return fmt.Errorf("failed to insert: %w", err)
+		}
 
-	db.logger.LogQuery(query, values, duration)
+		tx.logger.LogQuery(query, values, duration)
 
-	// Define o ID no modelo
-	setID(model, id)
+		// Define o ID no modelo
+		SetID(model, id)
 
-	return nil
+		return nil
+	})
 }
 
 // Update atualiza um registro existente.
+// Colunas readonly, id e created_at nunca são escritas; colunas omitempty
+// com valor zero são ignoradas.
 func (db *DB) Update(ctx context.Context, model interface{}) error {
-	tableName := getTableName(model)
-	id := getID(model)
-
-	if id == 0 {
+	if getID(model) == 0 {
 		return fmt.Errorf("cannot update model with zero ID")
 	}
 
+	tenant, err := db.modelTenant(ctx, model)
+	if err != nil {
+		return err
+	}
+
 	// Atualiza updated_at
 	setUpdatedAt(model)
 
@@ -155,53 +182,145 @@ func (db *DB) Update(ctx context.Context, model interface{}) error {
 		return fmt.Errorf("failed to get columns and values: %w", err)
 	}
 
-	// Remove 'id' das colunas a serem atualizadas
+	// Remove colunas imutáveis das colunas a serem atualizadas
 	filteredCols := []string{}
 	filteredVals := []interface{}{}
 	for i, col := range columns {
-		if col != "id" {
+		if !isImmutableColumn(col) {
 			filteredCols = append(filteredCols, col)
 			filteredVals = append(filteredVals, values[i])
 		}
 	}
 
+	return db.execUpdate(ctx, model, filteredCols, filteredVals, tenant)
+}
+
+// UpdateColumns atualiza apenas as colunas informadas, usando campos tipados.
+// updated_at é incluída automaticamente quando o modelo a possui.
+// As colunas são escritas mesmo com valor zero, ignorando omitempty.
+// Retorna erro se algum campo não pertencer ao modelo ou for readonly.
+//
+// Exemplo:
+//
+//	user.Name = "Novo Nome"
+//	db.UpdateColumns(ctx, &user, UserFields.Name)
+func (db *DB) UpdateColumns(ctx context.Context, model interface{}, fields ...ColumnNamer) error {
+	if len(fields) == 0 {
+		return fmt.Errorf("no columns to update")
+	}
+
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		columns[i] = field.ColumnName()
+	}
+
+	return db.updateColumns(ctx, model, columns)
+}
+
+// updateColumns atualiza as colunas informadas de um modelo.
+func (db *DB) updateColumns(ctx context.Context, model interface{}, columns []string) error {
+	if getID(model) == 0 {
+		return fmt.Errorf("cannot update model with zero ID")
+	}
+
+	tenant, err := db.modelTenant(ctx, model)
+	if err != nil {
+		return err
+	}
+
+	meta := getModelMeta(reflect.TypeOf(model))
+	v := reflect.Indirect(reflect.ValueOf(model))
+
+	for _, name := range columns {
+		col, ok := meta.column(name)
+		if !ok {
+			return fmt.Errorf("column %s not found in %T", name, model)
+		}
+		if col.opts.ReadOnly || isImmutableColumn(name) {
+			return fmt.Errorf("column %s is read-only", name)
+		}
+	}
+
+	// Atualiza updated_at só depois de validar as colunas, para não alterar
+	// o modelo quando o update é rejeitado
+	if _, ok := meta.column("updated_at"); ok {
+		setUpdatedAt(model)
+		if !containsString(columns, "updated_at") {
+			columns = append(columns, "updated_at")
+		}
+	}
+
+	var setCols []string
+	var setVals []interface{}
+	for _, name := range columns {
+		// omitempty não se aplica: a coluna foi pedida explicitamente, e
+		// limpar o valor (ex: nickname = "") é uma mudança legítima
+		col, _ := meta.column(name)
+		setCols = append(setCols, name)
+		setVals = append(setVals, v.FieldByIndex(col.index).Interface())
+	}
+
+	return db.execUpdate(ctx, model, setCols, setVals, tenant)
+}
+
+// execUpdate executa o UPDATE das colunas informadas filtrando pelo ID do modelo
+// e, no modo multi-tenant, pelo tenant.
+func (db *DB) execUpdate(ctx context.Context, model interface{}, columns []string, values []interface{}, tenant []interface{}) error {
+	if len(columns) == 0 {
+		return fmt.Errorf("no columns to update")
+	}
+
+	tableName := getTableName(model)
+	id := getID(model)
+
 	// Constrói SET clause
-	setParts := make([]string, len(filteredCols))
-	for i, col := range filteredCols {
+	setParts := make([]string, len(columns))
+	for i, col := range columns {
 		setParts[i] = fmt.Sprintf("%s = %s", col, db.dialect.Placeholder(i+1))
 	}
 
 	// Adiciona o ID como último parâmetro
-	filteredVals = append(filteredVals, id)
+	args := append(append([]interface{}{}, values...), id)
 
 	query := fmt.Sprintf(
 		"UPDATE %s SET %s WHERE id = %s",
 		db.dialect.QuoteIdentifier(tableName),
 		strings.Join(setParts, ", "),
-		db.dialect.Placeholder(len(filteredVals)),
+		db.dialect.Placeholder(len(args)),
 	)
+	query, args = db.appendTenantFilter(query, args, tenant)
 
-	start := time.Now()
-	result, err := db.executor.ExecContext(ctx, query, filteredVals...)
-	duration := time.Since(start).Nanoseconds()
+	return db.observe(ctx, OperationUpdate, model, func(tx *DB) error {
+		start := time.Now()
+		result, err := tx.executor.ExecContext(ctx, query, args...)
+		duration := time.Since(start).Nanoseconds()
 
-	if err != nil {
-		db.logger.LogError(query, filteredVals, err)
-		return fmt.Errorf("failed to update: %w", err)
-	}
+		if err != nil {
+			tx.logger.LogError(query, args, err)
+			return fmt.Errorf("failed to update: %w", err)
+		}
 
-	db.logger.LogQuery(query, filteredVals, duration)
+		tx.logger.LogQuery(query, args, duration)
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
 
-	if rows == 0 {
-		return fmt.Errorf("no rows updated")
-	}
+		if rows == 0 {
+			return fmt.Errorf("no rows updated")
+		}
 
-	return nil
+		return nil
+	})
+}
+
+// Save insere o modelo se ele ainda não tem ID, ou o atualiza caso contrário.
+func (db *DB) Save(ctx context.Context, model interface{}) error {
+	if getID(model) == 0 {
+		return db.Create(ctx, model)
+	}
+	return db.Update(ctx, model)
 }
 
 // Delete remove um registro do banco de dados.
@@ -213,33 +332,41 @@ func (db *DB) Delete(ctx context.Context, model interface{}) error {
 		return fmt.Errorf("cannot delete model with zero ID")
 	}
 
+	tenant, err := db.modelTenant(ctx, model)
+	if err != nil {
+		return err
+	}
+
 	query := fmt.Sprintf(
 		"DELETE FROM %s WHERE id = %s",
 		db.dialect.QuoteIdentifier(tableName),
 		db.dialect.Placeholder(1),
 	)
+	query, args := db.appendTenantFilter(query, []interface{}{id}, tenant)
 
-	start := time.Now()
-	result, err := db.executor.ExecContext(ctx, query, id)
-	duration := time.Since(start).Nanoseconds()
+	return db.observe(ctx, OperationDelete, model, func(tx *DB) error {
+		start := time.Now()
+		result, err := tx.executor.ExecContext(ctx, query, args...)
+		duration := time.Since(start).Nanoseconds()
 
-	if err != nil {
-		db.logger.LogError(query, []interface{}{id}, err)
-		return fmt.Errorf("failed to delete: %w", err)
-	}
+		if err != nil {
+			tx.logger.LogError(query, args, err)
+			return fmt.Errorf("failed to delete: %w", err)
+		}
 
-	db.logger.LogQuery(query, []interface{}{id}, duration)
+		tx.logger.LogQuery(query, args, duration)
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
 
-	if rows == 0 {
-		return fmt.Errorf("no rows deleted")
-	}
+		if rows == 0 {
+			return fmt.Errorf("no rows deleted")
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // Funções auxiliares usando reflection (uso mínimo)
@@ -257,49 +384,55 @@ func getTableName(model interface{}) string {
 	return toSnakeCase(t.Name())
 }
 
+// getColumnsAndValues retorna as colunas graváveis do modelo e seus valores.
+// Colunas readonly, colunas omitempty com valor zero e o id zero (gerado pelo banco)
+// são ignorados.
 func getColumnsAndValues(model interface{}) ([]string, []interface{}, error) {
 	v := reflect.ValueOf(model)
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
 	}
 
-	t := v.Type()
+	if v.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("model must be a struct, got %v", v.Kind())
+	}
+
+	meta := getModelMeta(v.Type())
+
 	var columns []string
 	var values []interface{}
 
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		fieldValue := v.Field(i)
-
-		// Pula campos não exportados
-		if !fieldValue.CanInterface() {
+	for _, col := range meta.columns {
+		if col.opts.ReadOnly {
 			continue
 		}
 
-		// Se é embedded struct (como Model), processa recursivamente
-		if field.Anonymous {
-			cols, vals, err := getColumnsAndValues(fieldValue.Addr().Interface())
-			if err != nil {
-				return nil, nil, err
-			}
-			columns = append(columns, cols...)
-			values = append(values, vals...)
+		fieldValue := v.FieldByIndex(col.index)
+		if (col.opts.OmitEmpty || col.name == "id") && fieldValue.IsZero() {
 			continue
 		}
 
-		// Pega o nome da coluna da tag db
-		colName := field.Tag.Get("db")
-		if colName == "" {
-			colName = toSnakeCase(field.Name)
-		}
-
-		columns = append(columns, colName)
+		columns = append(columns, col.name)
 		values = append(values, fieldValue.Interface())
 	}
 
 	return columns, values, nil
 }
 
+// isImmutableColumn indica se a coluna nunca deve ser alterada por um UPDATE.
+func isImmutableColumn(column string) bool {
+	return column == "id" || column == "created_at"
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
 func getID(model interface{}) int64 {
 	v := reflect.ValueOf(model)
 	if v.Kind() == reflect.Ptr {
@@ -315,16 +448,20 @@ func getID(model interface{}) int64 {
 	return idField.Int()
 }
 
-func setID(model interface{}, id int64) {
+// SetID define o ID de um modelo. Retorna false se o modelo não tem campo ID.
+func SetID(model interface{}, id int64) bool {
 	v := reflect.ValueOf(model)
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
 	}
 
 	idField := v.FieldByName("ID")
-	if idField.IsValid() && idField.CanSet() {
-		idField.SetInt(id)
+	if !idField.IsValid() || !idField.CanSet() {
+		return false
 	}
+
+	idField.SetInt(id)
+	return true
 }
 
 func setTimestamps(model interface{}) {
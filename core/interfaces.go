@@ -12,6 +12,10 @@ var ErrValidation = errors.New("validation failed")
 // Dialect define a interface para diferentes dialetos de banco de dados.
 // Cada dialeto (PostgreSQL, MySQL, SQLite) implementa esta interface.
 type Dialect interface {
+	// Name retorna o nome do dialeto ("postgres", "mysql" ou "sqlite").
+	// Usado para gerar SQL com sintaxe específica de cada banco.
+	Name() string
+
 	// Placeholder retorna o placeholder para a posição n (ex: $1 para PostgreSQL, ? para MySQL)
 	Placeholder(n int) string
 
@@ -19,6 +23,7 @@ type Dialect interface {
 	QuoteIdentifier(name string) string
 
 	// GetType retorna o tipo SQL para um tipo Go
+	// ("json" é usado para colunas core.JSON)
 	GetType(goType string) string
 }
 
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// JSON armazena um valor Go serializado como JSON em uma única coluna.
+// O migrate cria a coluna como JSONB no PostgreSQL, JSON no MySQL e TEXT no SQLite.
+//
+// Exemplo de uso:
+//
+//	type Settings struct {
+//	    Theme         string   `json:"theme"`
+//	    Notifications bool     `json:"notifications"`
+//	    Tags          []string `json:"tags"`
+//	}
+//
+//	type User struct {
+//	    core.Model
+//	    Settings core.JSON[Settings] `db:"settings"`
+//	}
+//
+//	user.Settings = core.NewJSON(Settings{Theme: "dark"})
+//	fmt.Println(user.Settings.Data.Theme)
+type JSON[T any] struct {
+	Data T
+}
+
+// NewJSON cria um JSON com o valor fornecido.
+func NewJSON[T any](data T) JSON[T] {
+	return JSON[T]{Data: data}
+}
+
+// --- Suporte a database/sql ---
+
+// Scan implementa sql.Scanner, desserializando o JSON lido do banco.
+// NULL resulta no valor zero de T.
+func (j *JSON[T]) Scan(value any) error {
+	var zero T
+	j.Data = zero
+
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("não foi possível converter %T para JSON", value)
+	}
+
+	if err := json.Unmarshal(data, &j.Data); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON column: %w", err)
+	}
+	return nil
+}
+
+// Value implementa driver.Valuer, serializando o valor como texto JSON.
+func (j JSON[T]) Value() (driver.Value, error) {
+	data, err := json.Marshal(j.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON column: %w", err)
+	}
+	return string(data), nil
+}
+
+// --- Suporte a JSON ---
+
+// MarshalJSON implementa json.Marshaler, serializando apenas o valor contido.
+func (j JSON[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(j.Data)
+}
+
+// UnmarshalJSON implementa json.Unmarshaler.
+func (j *JSON[T]) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &j.Data)
+}
@@ -0,0 +1,140 @@
+package core
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// TagOptions representa as opções declaradas após o nome da coluna na tag db.
+// Exemplo: `db:"created_at,readonly"` ou `db:"nickname,omitempty"`.
+type TagOptions struct {
+	// ReadOnly indica que a coluna nunca é escrita pelo Genus (INSERT ou UPDATE).
+	// Útil para colunas geradas ou preenchidas pelo banco.
+	ReadOnly bool
+	// OmitEmpty indica que a coluna é omitida da escrita quando o valor é zero.
+	OmitEmpty bool
+	// FullText indica que a coluna faz parte do índice full-text da tabela,
+	// criado pelo migrate. Exemplo: `db:"body,fulltext"` ou `db:"body,fulltext=english"`.
+	FullText bool
+	// FullTextLanguage é a configuração de busca do PostgreSQL (padrão DefaultFullTextLanguage).
+	FullTextLanguage string
+}
+
+// DefaultFullTextLanguage é a configuração de text search do PostgreSQL usada
+// quando a tag fulltext não define uma. "simple" não aplica stemming.
+const DefaultFullTextLanguage = "simple"
+
+// ParseTag separa o nome da coluna das opções de uma tag db.
+func ParseTag(tag string) (string, TagOptions) {
+	parts := strings.Split(tag, ",")
+	var opts TagOptions
+	for _, opt := range parts[1:] {
+		opt = strings.TrimSpace(opt)
+		switch {
+		case opt == "readonly":
+			opts.ReadOnly = true
+		case opt == "omitempty":
+			opts.OmitEmpty = true
+		case opt == "fulltext":
+			opts.FullText = true
+			opts.FullTextLanguage = DefaultFullTextLanguage
+		case strings.HasPrefix(opt, "fulltext="):
+			opts.FullText = true
+			opts.FullTextLanguage = strings.TrimPrefix(opt, "fulltext=")
+		}
+	}
+	return strings.TrimSpace(parts[0]), opts
+}
+
+// columnMeta descreve uma coluna mapeada a partir de um campo da struct.
+type columnMeta struct {
+	name      string
+	fieldName string
+	index     []int
+	opts      TagOptions
+}
+
+// modelMeta contém as colunas de um modelo, calculadas uma única vez por tipo.
+type modelMeta struct {
+	tableName string
+	columns   []columnMeta
+	byName    map[string]int
+}
+
+// column retorna a coluna com o nome informado.
+func (m *modelMeta) column(name string) (columnMeta, bool) {
+	i, ok := m.byName[name]
+	if !ok {
+		return columnMeta{}, false
+	}
+	return m.columns[i], true
+}
+
+// metaCache guarda os metadados por reflect.Type para evitar reflection repetida.
+var metaCache sync.Map
+
+// getModelMeta retorna os metadados (cacheados) do tipo do modelo.
+func getModelMeta(t reflect.Type) *modelMeta {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if cached, ok := metaCache.Load(t); ok {
+		return cached.(*modelMeta)
+	}
+
+	meta := &modelMeta{
+		tableName: getTableName(reflect.New(t).Interface()),
+		byName:    make(map[string]int),
+	}
+	if t.Kind() == reflect.Struct {
+		collectColumns(t, nil, meta)
+	}
+
+	actual, _ := metaCache.LoadOrStore(t, meta)
+	return actual.(*modelMeta)
+}
+
+// collectColumns percorre os campos da struct (incluindo embedded) e registra as colunas.
+func collectColumns(t reflect.Type, parentIndex []int, meta *modelMeta) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		index := append(append([]int(nil), parentIndex...), i)
+
+		// Se é embedded struct (como Model), processa recursivamente
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			collectColumns(field.Type, index, meta)
+			continue
+		}
+
+		// Pula campos não exportados
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("db")
+		if tag == "-" {
+			continue
+		}
+
+		colName, opts := ParseTag(tag)
+		if colName == "" {
+			colName = toSnakeCase(field.Name)
+		}
+
+		meta.byName[colName] = len(meta.columns)
+		meta.columns = append(meta.columns, columnMeta{
+			name:      colName,
+			fieldName: field.Name,
+			index:     index,
+			opts:      opts,
+		})
+	}
+}
+
+// TableNameOf retorna o nome da tabela do modelo T a partir dos metadados cacheados.
+func TableNameOf[T any]() string {
+	return getModelMeta(reflect.TypeOf((*T)(nil)).Elem()).tableName
+}
@@ -10,12 +10,36 @@ type Model struct {
 	UpdatedAt time.Time `db:"updated_at"`
 }
 
+// Identifiable é implementado pelos modelos com chave primária int64.
+// Modelos que embutem Model já o implementam; modelos com campo ID próprio
+// devem implementar GetID e SetID.
+type Identifiable interface {
+	GetID() int64
+	SetID(id int64)
+}
+
+// GetID retorna a chave primária do modelo.
+func (m Model) GetID() int64 {
+	return m.ID
+}
+
+// SetID define a chave primária do modelo.
+func (m *Model) SetID(id int64) {
+	m.ID = id
+}
+
 // TableNamer é uma interface que os modelos podem implementar
 // para especificar o nome da tabela customizado.
 type TableNamer interface {
 	TableName() string
 }
 
+// ColumnNamer é implementado por tudo que conhece o nome de uma coluna,
+// como os campos tipados do pacote query (query.StringField, query.IntField, etc.).
+type ColumnNamer interface {
+	ColumnName() string
+}
+
 // BeforeCreater é um hook executado antes de criar um registro.
 type BeforeCreater interface {
 	BeforeCreate() error
@@ -0,0 +1,190 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Operation identifica o tipo de escrita observada.
+type Operation string
+
+const (
+	OperationCreate Operation = "create"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+)
+
+// Change descreve uma escrita feita pelo DB em um modelo.
+type Change struct {
+	Table string
+	ID    int64
+	Op    Operation
+	Model interface{}
+
+	// Before e After são as colunas do registro antes e depois da escrita,
+	// lidas do banco. Só são preenchidos para observers que implementam
+	// SnapshotObserver; Before é nil no Create e After é nil no Delete.
+	Before map[string]interface{}
+	After  map[string]interface{}
+}
+
+// ChangeObserver é notificado de cada Create, Update e Delete do DB.
+// ObserveChange roda na mesma transação da escrita: tx executa na transação,
+// e um erro retornado desfaz a escrita.
+type ChangeObserver interface {
+	ObserveChange(ctx context.Context, tx *DB, change Change) error
+}
+
+// SnapshotObserver é implementado por observers que precisam de Change.Before
+// e Change.After (ex: auditoria). Cada snapshot custa um SELECT extra.
+type SnapshotObserver interface {
+	ChangeObserver
+	NeedsSnapshot() bool
+}
+
+// Observe registra um observer para as escritas deste DB e das transações
+// abertas a partir dele.
+func (db *DB) Observe(observer ChangeObserver) {
+	db.observers = append(db.observers, observer)
+}
+
+// observe executa write e notifica os observers na mesma transação.
+// Sem observers, write é executado diretamente. Após a escrita, as entradas
+// da tabela são removidas do cache.
+func (db *DB) observe(ctx context.Context, op Operation, model interface{}, write func(tx *DB) error) error {
+	if len(db.observers) == 0 {
+		if err := write(db); err != nil {
+			return err
+		}
+		db.InvalidateTable(getTableName(model))
+		return nil
+	}
+
+	err := db.inTx(ctx, func(tx *DB) error {
+		change := Change{
+			Table: getTableName(model),
+			Op:    op,
+			Model: model,
+		}
+
+		snapshot := tx.needsSnapshot()
+		if snapshot && op != OperationCreate {
+			before, err := tx.loadSnapshot(ctx, model)
+			if err != nil {
+				return err
+			}
+			change.Before = before
+		}
+
+		if err := write(tx); err != nil {
+			return err
+		}
+
+		change.ID = getID(model)
+		if snapshot && op != OperationDelete {
+			after, err := tx.loadSnapshot(ctx, model)
+			if err != nil {
+				return err
+			}
+			change.After = after
+		}
+
+		for _, observer := range tx.observers {
+			if err := observer.ObserveChange(ctx, tx, change); err != nil {
+				return fmt.Errorf("change observer failed: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	db.InvalidateTable(getTableName(model))
+	return nil
+}
+
+// inTx executa fn na transação atual ou, se não houver, em uma nova.
+func (db *DB) inTx(ctx context.Context, fn func(*DB) error) error {
+	if _, ok := db.executor.(*sql.Tx); ok {
+		return fn(db)
+	}
+	return db.WithTx(ctx, fn)
+}
+
+// needsSnapshot indica se algum observer precisa dos snapshots do registro.
+func (db *DB) needsSnapshot() bool {
+	for _, observer := range db.observers {
+		if so, ok := observer.(SnapshotObserver); ok && so.NeedsSnapshot() {
+			return true
+		}
+	}
+	return false
+}
+
+// loadSnapshot lê do banco as colunas do registro do modelo, pelo ID.
+// Retorna nil se o registro não existe.
+func (db *DB) loadSnapshot(ctx context.Context, model interface{}) (map[string]interface{}, error) {
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	meta := getModelMeta(t)
+
+	row := reflect.New(t).Elem()
+	columns := make([]string, len(meta.columns))
+	dest := make([]interface{}, len(meta.columns))
+	for i, col := range meta.columns {
+		columns[i] = col.name
+		dest[i] = row.FieldByIndex(col.index).Addr().Interface()
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM %s WHERE id = %s",
+		strings.Join(columns, ", "),
+		db.dialect.QuoteIdentifier(meta.tableName),
+		db.dialect.Placeholder(1),
+	)
+	id := getID(model)
+
+	err := db.executor.QueryRowContext(ctx, query, id).Scan(dest...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		db.logger.LogError(query, []interface{}{id}, err)
+		return nil, fmt.Errorf("failed to load snapshot: %w", err)
+	}
+
+	snapshot := make(map[string]interface{}, len(meta.columns))
+	for _, col := range meta.columns {
+		snapshot[col.name] = snapshotValue(row.FieldByIndex(col.index).Interface())
+	}
+	return snapshot, nil
+}
+
+// snapshotValue converte o valor de um campo para uma forma serializável em JSON.
+// Tipos com MarshalJSON são mantidos; outros driver.Valuer (ex: Optional) são
+// convertidos pelo próprio Value.
+func snapshotValue(value interface{}) interface{} {
+	if _, ok := value.(json.Marshaler); ok {
+		return value
+	}
+	if valuer, ok := value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return nil
+		}
+		value = v
+	}
+	if b, ok := value.([]byte); ok {
+		return string(b)
+	}
+	return value
+}
@@ -0,0 +1,198 @@
+package core_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+)
+
+// Account é o modelo usado nos testes de observers.
+type Account struct {
+	core.Model
+	Name    string `db:"name"`
+	Balance int    `db:"balance"`
+}
+
+// recordingObserver guarda as mudanças observadas.
+type recordingObserver struct {
+	snapshot bool
+	err      error
+	changes  []core.Change
+}
+
+func (o *recordingObserver) ObserveChange(ctx context.Context, tx *core.DB, change core.Change) error {
+	o.changes = append(o.changes, change)
+	return o.err
+}
+
+func (o *recordingObserver) NeedsSnapshot() bool {
+	return o.snapshot
+}
+
+// plainObserver não implementa core.SnapshotObserver.
+type plainObserver struct {
+	changes []core.Change
+}
+
+func (o *plainObserver) ObserveChange(ctx context.Context, tx *core.DB, change core.Change) error {
+	o.changes = append(o.changes, change)
+	return nil
+}
+
+// setupAccountDB cria um banco SQLite em memória com a tabela account.
+func setupAccountDB(t *testing.T) (*core.DB, *sql.DB) {
+	t.Helper()
+
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if _, err := sqlDB.Exec(`CREATE TABLE account (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT, balance INTEGER, created_at DATETIME, updated_at DATETIME)`); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+
+	return core.NewWithLogger(sqlDB, sqlite.New(), &queryLogger{}), sqlDB
+}
+
+// countAccounts retorna a quantidade de linhas da tabela account.
+func countAccounts(t *testing.T, sqlDB *sql.DB) int {
+	t.Helper()
+
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM account`).Scan(&count); err != nil {
+		t.Fatalf("Failed to count accounts: %v", err)
+	}
+	return count
+}
+
+func TestObserverSnapshots(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupAccountDB(t)
+	observer := &recordingObserver{snapshot: true}
+	db.Observe(observer)
+	ctx := context.Background()
+
+	account := &Account{Name: "alice", Balance: 10}
+	if err := db.Create(ctx, account); err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+	account.Balance = 20
+	if err := db.Update(ctx, account); err != nil {
+		t.Fatalf("Failed to update account: %v", err)
+	}
+	if err := db.Delete(ctx, account); err != nil {
+		t.Fatalf("Failed to delete account: %v", err)
+	}
+
+	if len(observer.changes) != 3 {
+		t.Fatalf("Expected 3 changes, got %d", len(observer.changes))
+	}
+	create, update, del := observer.changes[0], observer.changes[1], observer.changes[2]
+
+	for i, want := range []core.Operation{core.OperationCreate, core.OperationUpdate, core.OperationDelete} {
+		change := observer.changes[i]
+		if change.Op != want || change.Table != "account" || change.ID != account.ID {
+			t.Errorf("Unexpected change %d: %s %s #%d", i, change.Op, change.Table, change.ID)
+		}
+	}
+
+	// Create não tem Before e Delete não tem After
+	if create.Before != nil || create.After["balance"] != int(10) {
+		t.Errorf("Unexpected create snapshots: before %v, after %v", create.Before, create.After)
+	}
+	if update.Before["balance"] != int(10) || update.After["balance"] != int(20) {
+		t.Errorf("Unexpected update snapshots: before %v, after %v", update.Before, update.After)
+	}
+	if update.Before["name"] != "alice" || update.After["name"] != "alice" {
+		t.Errorf("Expected snapshots to include every column, got before %v, after %v", update.Before, update.After)
+	}
+	if del.Before["balance"] != int(20) || del.After != nil {
+		t.Errorf("Unexpected delete snapshots: before %v, after %v", del.Before, del.After)
+	}
+}
+
+func TestObserverWithoutSnapshot(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupAccountDB(t)
+	plain := &plainObserver{}
+	disabled := &recordingObserver{snapshot: false}
+	db.Observe(plain)
+	db.Observe(disabled)
+
+	account := &Account{Name: "alice"}
+	if err := db.Create(context.Background(), account); err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+	account.Name = "bob"
+	if err := db.Update(context.Background(), account); err != nil {
+		t.Fatalf("Failed to update account: %v", err)
+	}
+
+	for _, changes := range [][]core.Change{plain.changes, disabled.changes} {
+		if len(changes) != 2 {
+			t.Fatalf("Expected 2 changes, got %d", len(changes))
+		}
+		for _, change := range changes {
+			if change.Before != nil || change.After != nil {
+				t.Errorf("Expected no snapshots, got before %v, after %v", change.Before, change.After)
+			}
+			if change.Model != account {
+				t.Errorf("Expected change model to be the written model")
+			}
+		}
+	}
+}
+
+func TestObserverErrorRollsBackWrite(t *testing.T) {
+	t.Parallel()
+
+	db, sqlDB := setupAccountDB(t)
+	observerErr := errors.New("observer failed")
+	db.Observe(&recordingObserver{err: observerErr})
+
+	err := db.Create(context.Background(), &Account{Name: "alice"})
+	if !errors.Is(err, observerErr) {
+		t.Fatalf("Expected observer error, got %v", err)
+	}
+	if count := countAccounts(t, sqlDB); count != 0 {
+		t.Errorf("Expected create to be rolled back, got %d accounts", count)
+	}
+}
+
+func TestObserverInTransaction(t *testing.T) {
+	t.Parallel()
+
+	db, sqlDB := setupAccountDB(t)
+	observer := &recordingObserver{}
+	db.Observe(observer)
+	ctx := context.Background()
+
+	// Observers são propagados para a transação e rodam nela: o rollback
+	// da transação desfaz a escrita e o que o observer fez
+	rollback := errors.New("rollback")
+	err := db.WithTx(ctx, func(tx *core.DB) error {
+		if err := tx.Create(ctx, &Account{Name: "alice"}); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("Expected rollback error, got %v", err)
+	}
+
+	if len(observer.changes) != 1 {
+		t.Errorf("Expected observer to run inside the transaction, got %d changes", len(observer.changes))
+	}
+	if count := countAccounts(t, sqlDB); count != 0 {
+		t.Errorf("Expected create to be rolled back, got %d accounts", count)
+	}
+}
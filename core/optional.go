@@ -62,6 +62,11 @@ func FromPtr[T any](ptr *T) Optional[T] {
 	return Some(*ptr)
 }
 
+// deepCopy implementa deepCopier: o valor contido também é copiado.
+func (o Optional[T]) deepCopy() interface{} {
+	return Optional[T]{value: DeepCopy(o.value), valid: o.valid}
+}
+
 // IsPresent retorna true se o Optional contém um valor.
 func (o Optional[T]) IsPresent() bool {
 	return o.valid
@@ -0,0 +1,186 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// TenantColumn é a coluna que identifica o tenant. Modelos com essa coluna
+// são isolados por tenant quando o DB tem um TenantResolver configurado.
+const TenantColumn = "tenant_id"
+
+// ErrMissingTenant é retornado quando um modelo multi-tenant é consultado ou
+// escrito sem um tenant no contexto.
+var ErrMissingTenant = errors.New("missing tenant in context")
+
+// TenantResolver extrai o tenant do contexto. ok=false indica que não há tenant.
+type TenantResolver func(ctx context.Context) (tenant interface{}, ok bool)
+
+// SetTenantResolver ativa o modo multi-tenant neste DB com o resolver informado.
+// Com o modo ativo, todo SELECT, UPDATE e DELETE de modelos com a coluna
+// TenantColumn é filtrado por tenant_id = ?, e Create preenche a coluna.
+// O resolver é propagado para as transações e para os builders de genus.Table.
+// nil desativa o modo.
+//
+// Exemplo:
+//
+//	db.SetTenantResolver(core.TenantFromContext)
+//	ctx = core.WithTenant(ctx, 42)
+func (db *DB) SetTenantResolver(resolver TenantResolver) {
+	db.tenantResolver = resolver
+}
+
+// TenantResolver retorna o resolver de tenant atual, ou nil.
+func (db *DB) TenantResolver() TenantResolver {
+	return db.tenantResolver
+}
+
+type tenantKey struct{}
+
+type allTenantsKey struct{}
+
+// WithTenant retorna um contexto com o tenant informado, lido por TenantFromContext.
+func WithTenant(ctx context.Context, tenant interface{}) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext é o resolver padrão: lê o tenant gravado por WithTenant.
+func TenantFromContext(ctx context.Context) (interface{}, bool) {
+	tenant := ctx.Value(tenantKey{})
+	return tenant, tenant != nil
+}
+
+// AllTenants retorna um contexto que desativa o filtro de tenant.
+// Para jobs administrativos e de manutenção que precisam ver todos os tenants;
+// a intenção fica explícita no código em vez de depender de um tenant ausente.
+func AllTenants(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allTenantsKey{}, true)
+}
+
+// CurrentTenant resolve o tenant do contexto.
+// enabled é false quando o resolver é nil (modo multi-tenant desativado) ou o
+// contexto foi criado com AllTenants. Retorna ErrMissingTenant se o modo está
+// ativo e o resolver não encontra um tenant.
+func (resolver TenantResolver) CurrentTenant(ctx context.Context) (tenant interface{}, enabled bool, err error) {
+	if resolver == nil {
+		return nil, false, nil
+	}
+	if all, _ := ctx.Value(allTenantsKey{}).(bool); all {
+		return nil, false, nil
+	}
+
+	tenant, ok := resolver(ctx)
+	if !ok {
+		return nil, true, ErrMissingTenant
+	}
+	return tenant, true, nil
+}
+
+// IsTenantModel indica se o modelo possui a coluna TenantColumn.
+func IsTenantModel(t reflect.Type) bool {
+	_, ok := getModelMeta(t).column(TenantColumn)
+	return ok
+}
+
+// TenantValue converte o tenant para o tipo do campo TenantColumn do modelo,
+// com as mesmas regras usadas ao escrever o modelo (ver convertTenant).
+// O valor retornado é o usado no filtro tenant_id = ?.
+func TenantValue(model reflect.Type, tenant interface{}) (interface{}, error) {
+	col, ok := getModelMeta(model).column(TenantColumn)
+	if !ok {
+		return tenant, nil
+	}
+	if model.Kind() == reflect.Ptr {
+		model = model.Elem()
+	}
+	return convertTenant(tenant, model.FieldByIndex(col.index).Type)
+}
+
+// convertTenant converte o tenant para o tipo t do campo TenantColumn.
+// Aceita o mesmo tipo, conversões entre tipos numéricos sem perda (int -> int64,
+// mas não -1 -> uint) e entre tipos string (string -> type TenantID string).
+// Outras conversões, como int -> string, retornam erro: reflect as aceitaria
+// como conversão para rune ("*" para 42).
+func convertTenant(tenant interface{}, t reflect.Type) (interface{}, error) {
+	if tenant == nil {
+		return nil, ErrMissingTenant
+	}
+
+	value := reflect.ValueOf(tenant)
+	if value.Type() == t {
+		return tenant, nil
+	}
+
+	switch {
+	case isNumericKind(value.Kind()) && isNumericKind(t.Kind()):
+		converted := value.Convert(t)
+		if converted.Convert(value.Type()).Interface() != value.Interface() {
+			return nil, fmt.Errorf("tenant %v overflows %s (%s)", tenant, TenantColumn, t)
+		}
+		return converted.Interface(), nil
+	case value.Kind() == reflect.String && t.Kind() == reflect.String:
+		return value.Convert(t).Interface(), nil
+	}
+
+	return nil, fmt.Errorf("tenant of type %T cannot be stored in %s (%s)", tenant, TenantColumn, t)
+}
+
+// isNumericKind indica se kind é um tipo inteiro ou de ponto flutuante.
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// modelTenant resolve o tenant para escrever o modelo.
+// Preenche a coluna do tenant quando ela está vazia e recusa modelos de
+// outro tenant. Retorna o argumento do filtro de tenant, ou nil quando o
+// modelo não é filtrado (sem coluna de tenant ou modo desativado).
+func (db *DB) modelTenant(ctx context.Context, model interface{}) ([]interface{}, error) {
+	meta := getModelMeta(reflect.TypeOf(model))
+	col, ok := meta.column(TenantColumn)
+	if !ok {
+		return nil, nil
+	}
+
+	tenant, enabled, err := db.tenantResolver.CurrentTenant(ctx)
+	if !enabled {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	field := reflect.Indirect(reflect.ValueOf(model)).FieldByIndex(col.index)
+	converted, err := convertTenant(tenant, field.Type())
+	if err != nil {
+		return nil, err
+	}
+	value := reflect.ValueOf(converted)
+
+	if field.IsZero() {
+		if !field.CanSet() {
+			return nil, fmt.Errorf("cannot set %s: model must be a pointer", TenantColumn)
+		}
+		field.Set(value)
+	} else if !reflect.DeepEqual(field.Interface(), value.Interface()) {
+		return nil, fmt.Errorf("model belongs to tenant %v, not %v", field.Interface(), tenant)
+	}
+
+	return []interface{}{value.Interface()}, nil
+}
+
+// appendTenantFilter adiciona "AND tenant_id = ?" à cláusula WHERE de query.
+func (db *DB) appendTenantFilter(query string, args []interface{}, tenant []interface{}) (string, []interface{}) {
+	if len(tenant) == 0 {
+		return query, args
+	}
+	args = append(args, tenant...)
+	return query + fmt.Sprintf(" AND %s = %s", TenantColumn, db.dialect.Placeholder(len(args))), args
+}
@@ -0,0 +1,286 @@
+package core_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Invoice é um modelo multi-tenant com tenant numérico.
+type Invoice struct {
+	core.Model
+	TenantID int64 `db:"tenant_id"`
+	Total    int   `db:"total"`
+}
+
+// Note é um modelo multi-tenant com tenant textual.
+type Note struct {
+	core.Model
+	TenantID string `db:"tenant_id"`
+	Body     string `db:"body"`
+}
+
+// queryLogger guarda as queries executadas.
+type queryLogger struct {
+	queries []string
+}
+
+func (l *queryLogger) LogQuery(query string, args []interface{}, duration int64) {
+	l.queries = append(l.queries, query)
+}
+
+func (l *queryLogger) LogError(query string, args []interface{}, err error) {
+	l.queries = append(l.queries, query)
+}
+
+// setupTenantDB cria um banco SQLite em memória com o modo multi-tenant ativo.
+// O resolver é do DB, então os testes podem rodar em paralelo.
+func setupTenantDB(t *testing.T) (*core.DB, *sql.DB, *queryLogger) {
+	t.Helper()
+
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	for _, ddl := range []string{
+		`CREATE TABLE invoice (id INTEGER PRIMARY KEY AUTOINCREMENT, tenant_id INTEGER, total INTEGER, created_at DATETIME, updated_at DATETIME)`,
+		`CREATE TABLE note (id INTEGER PRIMARY KEY AUTOINCREMENT, tenant_id TEXT, body TEXT, created_at DATETIME, updated_at DATETIME)`,
+	} {
+		if _, err := sqlDB.Exec(ddl); err != nil {
+			t.Fatalf("Failed to create table: %v", err)
+		}
+	}
+
+	t.Cleanup(func() { sqlDB.Close() })
+
+	logger := &queryLogger{}
+	db := core.NewWithLogger(sqlDB, sqlite.New(), logger)
+	db.SetTenantResolver(core.TenantFromContext)
+	return db, sqlDB, logger
+}
+
+func TestCreateStampsTenant(t *testing.T) {
+	t.Parallel()
+
+	db, sqlDB, _ := setupTenantDB(t)
+	ctx := core.WithTenant(context.Background(), int64(7))
+
+	invoice := &Invoice{Total: 100}
+	if err := db.Create(ctx, invoice); err != nil {
+		t.Fatalf("Failed to create invoice: %v", err)
+	}
+
+	if invoice.TenantID != 7 {
+		t.Errorf("Expected TenantID 7, got %d", invoice.TenantID)
+	}
+
+	var stored int64
+	if err := sqlDB.QueryRow(`SELECT tenant_id FROM invoice WHERE id = ?`, invoice.ID).Scan(&stored); err != nil {
+		t.Fatalf("Failed to read invoice: %v", err)
+	}
+	if stored != 7 {
+		t.Errorf("Expected stored tenant_id 7, got %d", stored)
+	}
+}
+
+func TestCreateRejectsOtherTenant(t *testing.T) {
+	t.Parallel()
+
+	db, sqlDB, _ := setupTenantDB(t)
+	ctx := core.WithTenant(context.Background(), int64(7))
+
+	err := db.Create(ctx, &Invoice{TenantID: 8, Total: 100})
+	if err == nil || !strings.Contains(err.Error(), "belongs to tenant") {
+		t.Fatalf("Expected cross-tenant error, got %v", err)
+	}
+
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM invoice`).Scan(&count); err != nil {
+		t.Fatalf("Failed to count invoices: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected no invoices, got %d", count)
+	}
+}
+
+func TestUpdateAndDeleteFilterByTenant(t *testing.T) {
+	t.Parallel()
+
+	db, sqlDB, logger := setupTenantDB(t)
+	owner := core.WithTenant(context.Background(), int64(1))
+	other := core.WithTenant(context.Background(), int64(2))
+
+	invoice := &Invoice{Total: 100}
+	if err := db.Create(owner, invoice); err != nil {
+		t.Fatalf("Failed to create invoice: %v", err)
+	}
+
+	// Outro tenant conhecendo o ID: o modelo sem tenant recebe o tenant do
+	// contexto e o filtro não encontra a linha
+	stolen := &Invoice{Total: 0}
+	stolen.ID = invoice.ID
+	if err := db.Update(other, stolen); err == nil {
+		t.Error("Expected update from another tenant to fail")
+	}
+	if err := db.Delete(other, &Invoice{Model: core.Model{ID: invoice.ID}}); err == nil {
+		t.Error("Expected delete from another tenant to fail")
+	}
+
+	var total int
+	if err := sqlDB.QueryRow(`SELECT total FROM invoice WHERE id = ?`, invoice.ID).Scan(&total); err != nil {
+		t.Fatalf("Expected invoice to still exist: %v", err)
+	}
+	if total != 100 {
+		t.Errorf("Expected total 100, got %d", total)
+	}
+
+	for _, prefix := range []string{"UPDATE", "DELETE"} {
+		found := false
+		for _, query := range logger.queries {
+			if strings.HasPrefix(query, prefix) {
+				found = true
+				if !strings.HasSuffix(query, "AND tenant_id = ?") {
+					t.Errorf("Expected %s filtered by tenant, got %s", prefix, query)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("Expected a %s query to be executed", prefix)
+		}
+	}
+
+	// O próprio tenant atualiza e remove normalmente
+	invoice.Total = 200
+	if err := db.Update(owner, invoice); err != nil {
+		t.Fatalf("Failed to update invoice: %v", err)
+	}
+	if err := db.Delete(owner, invoice); err != nil {
+		t.Fatalf("Failed to delete invoice: %v", err)
+	}
+}
+
+func TestWritesRequireTenant(t *testing.T) {
+	t.Parallel()
+
+	db, _, _ := setupTenantDB(t)
+	ctx := context.Background()
+
+	invoice := &Invoice{Total: 100}
+	if err := db.Create(ctx, invoice); !errors.Is(err, core.ErrMissingTenant) {
+		t.Errorf("Expected ErrMissingTenant on create, got %v", err)
+	}
+
+	invoice.ID = 1
+	if err := db.Update(ctx, invoice); !errors.Is(err, core.ErrMissingTenant) {
+		t.Errorf("Expected ErrMissingTenant on update, got %v", err)
+	}
+	if err := db.Delete(ctx, invoice); !errors.Is(err, core.ErrMissingTenant) {
+		t.Errorf("Expected ErrMissingTenant on delete, got %v", err)
+	}
+
+	// AllTenants desativa o filtro explicitamente
+	if err := db.Create(core.AllTenants(ctx), &Invoice{TenantID: 3, Total: 100}); err != nil {
+		t.Errorf("Expected create with AllTenants to succeed, got %v", err)
+	}
+}
+
+func TestCreateRejectsIncompatibleTenantType(t *testing.T) {
+	t.Parallel()
+
+	db, _, _ := setupTenantDB(t)
+
+	// int -> string seria convertido para o rune "*"
+	err := db.Create(core.WithTenant(context.Background(), 42), &Note{Body: "hello"})
+	if err == nil {
+		t.Fatal("Expected int tenant to be rejected for a string tenant column")
+	}
+
+	note := &Note{Body: "hello"}
+	if err := db.Create(core.WithTenant(context.Background(), "acme"), note); err != nil {
+		t.Fatalf("Failed to create note: %v", err)
+	}
+	if note.TenantID != "acme" {
+		t.Errorf("Expected TenantID acme, got %q", note.TenantID)
+	}
+}
+
+func TestTenantValue(t *testing.T) {
+	t.Parallel()
+
+	type TenantID string
+
+	type Tenanted struct {
+		TenantID TenantID `db:"tenant_id"`
+	}
+	type Unsigned struct {
+		TenantID uint32 `db:"tenant_id"`
+	}
+
+	tests := []struct {
+		name    string
+		model   reflect.Type
+		tenant  interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{"same type", reflect.TypeOf(Invoice{}), int64(7), int64(7), false},
+		{"int to int64", reflect.TypeOf(Invoice{}), 7, int64(7), false},
+		{"string to int64", reflect.TypeOf(Invoice{}), "7", nil, true},
+		{"int to string", reflect.TypeOf(Note{}), 42, nil, true},
+		{"string to named string", reflect.TypeOf(Tenanted{}), "acme", TenantID("acme"), false},
+		{"int to uint32", reflect.TypeOf(Unsigned{}), 7, uint32(7), false},
+		{"negative to uint32", reflect.TypeOf(Unsigned{}), -1, nil, true},
+		{"float with fraction to int64", reflect.TypeOf(Invoice{}), 1.5, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := core.TenantValue(tt.model, tt.tenant)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got %#v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %#v, got %#v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTenantResolverIsPerDB(t *testing.T) {
+	t.Parallel()
+
+	db, sqlDB, _ := setupTenantDB(t)
+	ctx := context.Background()
+
+	// Outro DB sobre a mesma conexão, sem resolver: o modo multi-tenant é do DB
+	plain := core.New(sqlDB, sqlite.New())
+	if plain.TenantResolver() != nil {
+		t.Fatal("Expected new DB to have no tenant resolver")
+	}
+	if err := plain.Create(ctx, &Invoice{TenantID: 3, Total: 100}); err != nil {
+		t.Errorf("Expected create without resolver to succeed, got %v", err)
+	}
+
+	// O resolver é propagado para as transações
+	err := db.WithTx(ctx, func(tx *core.DB) error {
+		return tx.Create(ctx, &Invoice{Total: 100})
+	})
+	if !errors.Is(err, core.ErrMissingTenant) {
+		t.Errorf("Expected ErrMissingTenant inside transaction, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"reflect"
+)
+
+// Tracker guarda um snapshot dos valores de um modelo para detectar
+// quais colunas foram modificadas desde que ele foi carregado.
+//
+// Exemplo de uso:
+//
+//	user, _ := genus.Table[User](g).Where(UserFields.ID.Eq(1)).First(ctx)
+//	tracker := core.Track(&user)
+//
+//	user.Name = "Novo Nome"
+//	db.UpdateChanged(ctx, tracker) // UPDATE "users" SET name = $1, updated_at = $2 WHERE id = $3
+//
+// O snapshot é raso: slices e maps alterados in-place não são detectados.
+type Tracker struct {
+	model    interface{}
+	snapshot map[string]interface{}
+}
+
+// Track cria um Tracker para o modelo. model deve ser um ponteiro para struct.
+func Track(model interface{}) *Tracker {
+	t := &Tracker{model: model}
+	t.Reset()
+	return t
+}
+
+// Model retorna o modelo rastreado.
+func (t *Tracker) Model() interface{} {
+	return t.model
+}
+
+// Reset descarta as mudanças detectadas, tirando um novo snapshot do modelo.
+func (t *Tracker) Reset() {
+	v := reflect.Indirect(reflect.ValueOf(t.model))
+	meta := getModelMeta(v.Type())
+
+	t.snapshot = make(map[string]interface{}, len(meta.columns))
+	for _, col := range meta.columns {
+		t.snapshot[col.name] = v.FieldByIndex(col.index).Interface()
+	}
+}
+
+// Changed retorna as colunas graváveis modificadas desde o último snapshot.
+// Colunas readonly, id e created_at nunca são reportadas.
+func (t *Tracker) Changed() []string {
+	v := reflect.Indirect(reflect.ValueOf(t.model))
+	meta := getModelMeta(v.Type())
+
+	var changed []string
+	for _, col := range meta.columns {
+		if col.opts.ReadOnly || isImmutableColumn(col.name) {
+			continue
+		}
+
+		current := v.FieldByIndex(col.index).Interface()
+		if !reflect.DeepEqual(current, t.snapshot[col.name]) {
+			changed = append(changed, col.name)
+		}
+	}
+
+	return changed
+}
+
+// IsChanged retorna true se alguma coluna gravável foi modificada.
+func (t *Tracker) IsChanged() bool {
+	return len(t.Changed()) > 0
+}
+
+// UpdateChanged atualiza apenas as colunas modificadas desde o snapshot do tracker.
+// Se nada mudou, nenhuma query é executada. Colunas omitempty zeradas também são
+// escritas. Após o sucesso, um novo snapshot é tirado.
+func (db *DB) UpdateChanged(ctx context.Context, tracker *Tracker) error {
+	changed := tracker.Changed()
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if err := db.updateColumns(ctx, tracker.model, changed); err != nil {
+		return err
+	}
+
+	tracker.Reset()
+	return nil
+}
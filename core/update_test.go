@@ -0,0 +1,143 @@
+package core_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+)
+
+// Profile tem uma coluna omitempty e uma readonly.
+type Profile struct {
+	core.Model
+	Name     string `db:"name"`
+	Nickname string `db:"nickname,omitempty"`
+	Slug     string `db:"slug,readonly"`
+}
+
+// column é um core.ColumnNamer simples para os testes.
+type column string
+
+func (c column) ColumnName() string { return string(c) }
+
+// setupProfileDB cria um banco SQLite em memória com a tabela profile e um perfil.
+func setupProfileDB(t *testing.T) (*core.DB, *sql.DB, *queryLogger, *Profile) {
+	t.Helper()
+
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if _, err := sqlDB.Exec(`CREATE TABLE profile (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT, nickname TEXT, slug TEXT, created_at DATETIME, updated_at DATETIME)`); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+
+	logger := &queryLogger{}
+	db := core.NewWithLogger(sqlDB, sqlite.New(), logger)
+
+	profile := &Profile{Name: "Alice", Nickname: "ali"}
+	if err := db.Create(context.Background(), profile); err != nil {
+		t.Fatalf("Failed to create profile: %v", err)
+	}
+	logger.queries = nil
+
+	return db, sqlDB, logger, profile
+}
+
+// storedNickname lê o nickname gravado no banco.
+func storedNickname(t *testing.T, sqlDB *sql.DB, id int64) string {
+	t.Helper()
+
+	var nickname string
+	if err := sqlDB.QueryRow(`SELECT nickname FROM profile WHERE id = ?`, id).Scan(&nickname); err != nil {
+		t.Fatalf("Failed to read profile: %v", err)
+	}
+	return nickname
+}
+
+func TestUpdateColumnsWritesZeroOmitEmpty(t *testing.T) {
+	db, sqlDB, logger, profile := setupProfileDB(t)
+
+	profile.Nickname = ""
+	if err := db.UpdateColumns(context.Background(), profile, column("nickname")); err != nil {
+		t.Fatalf("UpdateColumns failed: %v", err)
+	}
+
+	if got := storedNickname(t, sqlDB, profile.ID); got != "" {
+		t.Errorf("Expected nickname to be cleared, got %q", got)
+	}
+	if len(logger.queries) != 1 || !strings.HasPrefix(logger.queries[0], `UPDATE "profile" SET nickname = ?, updated_at = ?`) {
+		t.Errorf("Unexpected queries: %v", logger.queries)
+	}
+}
+
+func TestUpdateColumnsRejectsInvalidColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		column  string
+		wantErr string
+	}{
+		{"unknown", "nope", "column nope not found"},
+		{"readonly", "slug", "column slug is read-only"},
+		{"id", "id", "column id is read-only"},
+		{"created_at", "created_at", "column created_at is read-only"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, _, logger, profile := setupProfileDB(t)
+			updatedAt := profile.UpdatedAt
+
+			err := db.UpdateColumns(context.Background(), profile, column("name"), column(tt.column))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if !profile.UpdatedAt.Equal(updatedAt) {
+				t.Error("Expected updated_at to be unchanged after a rejected update")
+			}
+			if len(logger.queries) != 0 {
+				t.Errorf("Expected no queries, got %v", logger.queries)
+			}
+		})
+	}
+}
+
+func TestUpdateChanged(t *testing.T) {
+	db, sqlDB, logger, profile := setupProfileDB(t)
+	ctx := context.Background()
+	tracker := core.Track(profile)
+
+	// Sem mudanças nenhuma query é executada
+	if err := db.UpdateChanged(ctx, tracker); err != nil {
+		t.Fatalf("UpdateChanged failed: %v", err)
+	}
+	if len(logger.queries) != 0 {
+		t.Fatalf("Expected no queries, got %v", logger.queries)
+	}
+
+	// Limpar uma coluna omitempty é uma mudança e precisa ser gravada
+	profile.Nickname = ""
+	profile.Slug = "ignored"
+	if changed := tracker.Changed(); len(changed) != 1 || changed[0] != "nickname" {
+		t.Fatalf("Expected only nickname to be changed, got %v", changed)
+	}
+	if err := db.UpdateChanged(ctx, tracker); err != nil {
+		t.Fatalf("UpdateChanged failed: %v", err)
+	}
+
+	if got := storedNickname(t, sqlDB, profile.ID); got != "" {
+		t.Errorf("Expected nickname to be cleared, got %q", got)
+	}
+	if tracker.IsChanged() {
+		t.Errorf("Expected tracker to be reset, got changes %v", tracker.Changed())
+	}
+	if len(logger.queries) != 1 || !strings.HasPrefix(logger.queries[0], `UPDATE "profile" SET nickname = ?, updated_at = ?`) {
+		t.Errorf("Unexpected queries: %v", logger.queries)
+	}
+}
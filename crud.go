@@ -0,0 +1,63 @@
+package genus
+
+import (
+	"context"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/query"
+)
+
+// Funções CRUD genéricas.
+// Equivalentes a db.DB().Create(ctx, model), mas o tipo do modelo é verificado
+// em tempo de compilação: o argumento deve ser um *T que implementa core.Identifiable
+// (todo modelo que embute core.Model). Passar um valor ao invés de *T, ou um
+// ponteiro para um tipo que não é modelo (como *int), não compila.
+//
+// Exemplo:
+//
+//	user := &User{Name: "Alice"}
+//	err := genus.Create(ctx, g, user)
+//
+//	found, err := genus.FindByID[User](ctx, g, user.ID)
+
+// ModelPtr restringe as funções CRUD a ponteiros para modelos.
+// PT é inferido de T: genus.FindByID[User] usa PT = *User.
+type ModelPtr[T any] interface {
+	*T
+	core.Identifiable
+}
+
+// Create insere um novo registro do tipo T.
+func Create[T any, PT ModelPtr[T]](ctx context.Context, g *Genus, model PT) error {
+	return g.db.Create(ctx, model)
+}
+
+// Save insere o registro se ele ainda não tem ID, ou o atualiza caso contrário.
+func Save[T any, PT ModelPtr[T]](ctx context.Context, g *Genus, model PT) error {
+	return g.db.Save(ctx, model)
+}
+
+// Update atualiza um registro existente do tipo T.
+func Update[T any, PT ModelPtr[T]](ctx context.Context, g *Genus, model PT) error {
+	return g.db.Update(ctx, model)
+}
+
+// Delete remove um registro do tipo T.
+func Delete[T any, PT ModelPtr[T]](ctx context.Context, g *Genus, model PT) error {
+	return g.db.Delete(ctx, model)
+}
+
+// FindByID busca um registro do tipo T pelo ID.
+func FindByID[T any, PT ModelPtr[T]](ctx context.Context, g *Genus, id int64) (T, error) {
+	return Table[T](g).Where(idField.Eq(id)).First(ctx)
+}
+
+// DeleteByID remove um registro do tipo T pelo ID, sem precisar carregá-lo antes.
+func DeleteByID[T any, PT ModelPtr[T]](ctx context.Context, g *Genus, id int64) error {
+	model := PT(new(T))
+	model.SetID(id)
+	return g.db.Delete(ctx, model)
+}
+
+// idField é a coluna de chave primária usada por core.Model.
+var idField = query.NewInt64Field("id")
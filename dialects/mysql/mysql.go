@@ -12,6 +12,11 @@ func New() *Dialect {
 	return &Dialect{}
 }
 
+// Name retorna o nome do dialeto.
+func (d *Dialect) Name() string {
+	return "mysql"
+}
+
 // Placeholder retorna o placeholder para MySQL (?).
 // MySQL usa placeholders posicionais simples (?) ao invés de numerados.
 func (d *Dialect) Placeholder(n int) string {
@@ -34,6 +39,7 @@ func (d *Dialect) GetType(goType string) string {
 		"time.Time": "DATETIME",
 		"float64":   "DOUBLE",
 		"float32":   "FLOAT",
+		"json":      "JSON",
 	}
 
 	if sqlType, ok := typeMap[goType]; ok {
@@ -12,6 +12,11 @@ func New() *Dialect {
 	return &Dialect{}
 }
 
+// Name retorna o nome do dialeto.
+func (d *Dialect) Name() string {
+	return "postgres"
+}
+
 // Placeholder retorna o placeholder para PostgreSQL ($1, $2, etc).
 func (d *Dialect) Placeholder(n int) string {
 	return fmt.Sprintf("$%d", n)
@@ -32,6 +37,7 @@ func (d *Dialect) GetType(goType string) string {
 		"time.Time": "TIMESTAMP",
 		"float64":   "DOUBLE PRECISION",
 		"float32":   "REAL",
+		"json":      "JSONB",
 	}
 
 	if sqlType, ok := typeMap[goType]; ok {
@@ -0,0 +1,72 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sync"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+)
+
+// DriverName é o nome do driver database/sql do go-sqlite3 com as funções
+// usadas pelo Genus registradas (ex: REGEXP, usada por StringField.Regexp).
+//
+// Exemplo:
+//
+//	sqlDB, err := sql.Open(sqlite.DriverName, "app.db")
+//	g := genus.New(sqlDB, sqlite.New())
+const DriverName = "genus_sqlite3"
+
+func init() {
+	sql.Register(DriverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("regexp", regexpMatch, true)
+		},
+	})
+}
+
+// regexCache guarda as expressões já compiladas, por padrão.
+var regexCache sync.Map
+
+// regexpMatch implementa "value REGEXP pattern"; o SQLite chama regexp(pattern, value).
+// Como os operadores nativos, retorna NULL se value for NULL.
+func regexpMatch(pattern string, value interface{}) (interface{}, error) {
+	var text string
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		text = v
+	case []byte:
+		// O go-sqlite3 entrega NULL como um []byte nil
+		if v == nil {
+			return nil, nil
+		}
+		text = string(v)
+	default:
+		text = fmt.Sprint(v)
+	}
+
+	re, err := compileRegexp(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return re.MatchString(text), nil
+}
+
+// compileRegexp compila pattern, reutilizando expressões já compiladas.
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	if cached, ok := regexCache.Load(pattern); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexCache.Store(pattern, re)
+
+	return re, nil
+}
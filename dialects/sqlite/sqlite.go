@@ -12,6 +12,11 @@ func New() *Dialect {
 	return &Dialect{}
 }
 
+// Name retorna o nome do dialeto.
+func (d *Dialect) Name() string {
+	return "sqlite"
+}
+
 // Placeholder retorna o placeholder para SQLite (?).
 // SQLite usa placeholders posicionais simples (?) ao invés de numerados.
 func (d *Dialect) Placeholder(n int) string {
@@ -35,6 +40,7 @@ func (d *Dialect) GetType(goType string) string {
 		"time.Time": "DATETIME",
 		"float64":   "REAL",
 		"float32":   "REAL",
+		"json":      "TEXT",
 	}
 
 	if sqlType, ok := typeMap[goType]; ok {
@@ -34,16 +34,16 @@ var UserFields = struct {
 	Email     query.OptionalStringField
 	Age       query.OptionalIntField
 	IsActive  query.BoolField
-	CreatedAt query.StringField
-	UpdatedAt query.StringField
+	CreatedAt query.TimeField
+	UpdatedAt query.TimeField
 }{
 	ID:        query.NewInt64Field("id"),
 	Name:      query.NewStringField("name"),
 	Email:     query.NewOptionalStringField("email"),
 	Age:       query.NewOptionalIntField("age"),
 	IsActive:  query.NewBoolField("is_active"),
-	CreatedAt: query.NewStringField("created_at"),
-	UpdatedAt: query.NewStringField("updated_at"),
+	CreatedAt: query.NewTimeField("created_at"),
+	UpdatedAt: query.NewTimeField("updated_at"),
 }
 
 func main() {
@@ -39,8 +39,8 @@ var ProductFields = struct {
 	MinStock    query.OptionalIntField
 	Active      query.BoolField
 	Featured    query.OptionalBoolField
-	CreatedAt   query.StringField
-	UpdatedAt   query.StringField
+	CreatedAt   query.TimeField
+	UpdatedAt   query.TimeField
 }{
 	ID:          query.NewInt64Field("id"),
 	Name:        query.NewStringField("name"),
@@ -51,8 +51,8 @@ var ProductFields = struct {
 	MinStock:    query.NewOptionalIntField("min_stock"),
 	Active:      query.NewBoolField("active"),
 	Featured:    query.NewOptionalBoolField("featured"),
-	CreatedAt:   query.NewStringField("created_at"),
-	UpdatedAt:   query.NewStringField("updated_at"),
+	CreatedAt:   query.NewTimeField("created_at"),
+	UpdatedAt:   query.NewTimeField("updated_at"),
 }
 
 func main() {
@@ -35,21 +35,19 @@ var UserFields = struct {
 	IsActive: query.NewBoolField("is_active"),
 }
 
-// UserRepository encapsula operações de usuário
+// UserRepository encapsula operações de usuário.
+// As operações comuns (Create, Update, Delete, FindByID, Paginate...) vêm de
+// genus.Repository[User, *User]; aqui ficam apenas as queries de domínio.
 type UserRepository struct {
-	db *genus.Genus
+	*genus.Repository[User, *User]
 }
 
 func NewUserRepository(db *genus.Genus) *UserRepository {
-	return &UserRepository{db: db}
-}
-
-func (r *UserRepository) Create(ctx context.Context, user *User) error {
-	return r.db.DB().Create(ctx, user)
+	return &UserRepository{Repository: genus.NewRepository[User](db)}
 }
 
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*User, error) {
-	user, err := genus.Table[User](r.db).
+	user, err := r.Query().
 		Where(UserFields.Email.Eq(email)).
 		First(ctx)
 	if err != nil {
@@ -59,23 +57,11 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*User,
 }
 
 func (r *UserRepository) FindActive(ctx context.Context) ([]User, error) {
-	return genus.Table[User](r.db).
-		Where(UserFields.IsActive.Eq(true)).
-		Find(ctx)
+	return r.FindWhere(ctx, UserFields.IsActive.Eq(true))
 }
 
 func (r *UserRepository) FindByAgeRange(ctx context.Context, minAge, maxAge int) ([]User, error) {
-	return genus.Table[User](r.db).
-		Where(UserFields.Age.Between(minAge, maxAge)).
-		Find(ctx)
-}
-
-func (r *UserRepository) Update(ctx context.Context, user *User) error {
-	return r.db.DB().Update(ctx, user)
-}
-
-func (r *UserRepository) Delete(ctx context.Context, user *User) error {
-	return r.db.DB().Delete(ctx, user)
+	return r.FindWhere(ctx, UserFields.Age.Between(minAge, maxAge))
 }
 
 // setupTestDB cria um banco de dados de teste em memória (SQLite)
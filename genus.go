@@ -1,9 +1,8 @@
 package genus
 
 import (
+	"context"
 	"database/sql"
-	"reflect"
-	"strings"
 
 	"github.com/GabrielOnRails/genus/core"
 	"github.com/GabrielOnRails/genus/dialects/postgres"
@@ -50,35 +49,30 @@ func (g *Genus) DB() *core.DB {
 	return g.db
 }
 
+// WithTx executa uma função dentro de uma transação.
+// O *Genus recebido por fn usa a transação, então genus.Table e as funções
+// CRUD genéricas chamadas com ele participam da mesma transação.
+func (g *Genus) WithTx(ctx context.Context, fn func(tx *Genus) error) error {
+	return g.db.WithTx(ctx, func(txDB *core.DB) error {
+		return fn(&Genus{db: txDB})
+	})
+}
+
 // Table cria um query builder type-safe para o tipo T.
 // Esta é a função mágica que permite: genus.Table[User]().Where(...)
 func Table[T any](g *Genus) *query.Builder[T] {
-	var model T
-	tableName := getTableName(model)
-	return query.NewBuilder[T](g.db.Executor(), g.db.Dialect(), g.db.Logger(), tableName)
-}
-
-// getTableName obtém o nome da tabela para um modelo.
-func getTableName(model interface{}) string {
-	if tn, ok := model.(core.TableNamer); ok {
-		return tn.TableName()
-	}
-
-	t := reflect.TypeOf(model)
-	if t.Kind() == reflect.Ptr {
-		t = t.Elem()
-	}
-	return toSnakeCase(t.Name())
+	return query.NewBuilder[T](g.db.Executor(), g.db.Dialect(), g.db.Logger(), core.TableNameOf[T]()).
+		UseCache(g.db.Cache()).
+		UseTenantResolver(g.db.TenantResolver())
 }
 
-// toSnakeCase converte CamelCase para snake_case.
-func toSnakeCase(s string) string {
-	var result strings.Builder
-	for i, r := range s {
-		if i > 0 && r >= 'A' && r <= 'Z' {
-			result.WriteRune('_')
-		}
-		result.WriteRune(r)
-	}
-	return strings.ToLower(result.String())
+// Raw cria uma query SQL escrita à mão que retorna []T.
+// Use "?" como placeholder; ele é reescrito para o dialeto ($1, $2, ... no PostgreSQL).
+// A query passa pelo logger e pelo mesmo mapeamento de colunas do query builder.
+//
+// Exemplo:
+//
+//	users, err := genus.Raw[User](g, "SELECT * FROM users WHERE lower(email) = ?", email).Find(ctx)
+func Raw[T any](g *Genus, sql string, args ...interface{}) *query.RawQuery[T] {
+	return query.NewRawQuery[T](g.db.Executor(), g.db.Dialect(), g.db.Logger(), sql, args...)
 }
@@ -0,0 +1,60 @@
+package genus
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+)
+
+// Ticket é um modelo multi-tenant.
+type Ticket struct {
+	core.Model
+	TenantID int64  `db:"tenant_id"`
+	Title    string `db:"title"`
+}
+
+func TestTableUsesTenantResolver(t *testing.T) {
+	t.Parallel()
+
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if _, err := sqlDB.Exec(`CREATE TABLE ticket (id INTEGER PRIMARY KEY AUTOINCREMENT, tenant_id INTEGER, title TEXT, created_at DATETIME, updated_at DATETIME)`); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+
+	g := NewWithLogger(sqlDB, sqlite.New(), &queryLogger{})
+	g.DB().SetTenantResolver(core.TenantFromContext)
+
+	for _, tenant := range []int64{1, 1, 2} {
+		ctx := core.WithTenant(context.Background(), tenant)
+		if err := Create(ctx, g, &Ticket{Title: "t"}); err != nil {
+			t.Fatalf("Failed to create ticket: %v", err)
+		}
+	}
+
+	tickets, err := Table[Ticket](g).Find(core.WithTenant(context.Background(), int64(1)))
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	if len(tickets) != 2 {
+		t.Errorf("Expected 2 tickets for tenant 1, got %d", len(tickets))
+	}
+
+	// Dentro da transação o builder usa o mesmo resolver
+	err = g.WithTx(context.Background(), func(tx *Genus) error {
+		_, err := Table[Ticket](tx).Count(context.Background())
+		return err
+	})
+	if !errors.Is(err, core.ErrMissingTenant) {
+		t.Errorf("Expected ErrMissingTenant inside transaction, got %v", err)
+	}
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/GabrielOnRails/genus/core"
 )
 
+// corePkgPath é o caminho do pacote core, usado para reconhecer seus tipos genéricos.
+var corePkgPath = reflect.TypeOf(core.Model{}).PkgPath()
+
 // AutoMigrate cria automaticamente tabelas a partir de structs.
 // Útil para desenvolvimento rápido, mas não recomendado para produção.
 func AutoMigrate(ctx context.Context, db *sql.DB, dialect core.Dialect, models ...interface{}) error {
@@ -53,6 +56,7 @@ func createTableFromStruct(ctx context.Context, db *sql.DB, dialect core.Dialect
 
 	// Construir colunas
 	var columns []string
+	var search fullTextIndex
 
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
@@ -69,6 +73,7 @@ func createTableFromStruct(ctx context.Context, db *sql.DB, dialect core.Dialect
 				embeddedField := embeddedType.Field(j)
 				if col := buildColumnDefinition(embeddedField, dialect); col != "" {
 					columns = append(columns, col)
+					search.add(embeddedField)
 				}
 			}
 			continue
@@ -77,6 +82,7 @@ func createTableFromStruct(ctx context.Context, db *sql.DB, dialect core.Dialect
 		// Processar campo normal
 		if col := buildColumnDefinition(field, dialect); col != "" {
 			columns = append(columns, col)
+			search.add(field)
 		}
 	}
 
@@ -84,6 +90,11 @@ func createTableFromStruct(ctx context.Context, db *sql.DB, dialect core.Dialect
 		return fmt.Errorf("no columns found in struct %v", t.Name())
 	}
 
+	// MySQL: o índice FULLTEXT é declarado junto com a tabela
+	if len(search.columns) > 0 && dialect.Name() == "mysql" {
+		columns = append(columns, search.mysqlDefinition(dialect, tableName))
+	}
+
 	// Construir query CREATE TABLE
 	query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n  %s\n)",
 		dialect.QuoteIdentifier(tableName),
@@ -94,6 +105,13 @@ func createTableFromStruct(ctx context.Context, db *sql.DB, dialect core.Dialect
 		return fmt.Errorf("failed to create table: %w", err)
 	}
 
+	// PostgreSQL (índice GIN) e SQLite (tabela FTS5 e triggers)
+	for _, stmt := range search.statements(dialect, tableName) {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to create full-text index: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -101,6 +119,13 @@ func createTableFromStruct(ctx context.Context, db *sql.DB, dialect core.Dialect
 func dropTable(ctx context.Context, db *sql.DB, dialect core.Dialect, model interface{}) error {
 	tableName := getTableName(model)
 
+	// SQLite: a tabela FTS5 não é removida junto com a tabela de conteúdo
+	if dialect.Name() == "sqlite" {
+		if _, err := db.ExecContext(ctx, dropFTSTable(dialect, tableName)); err != nil {
+			return fmt.Errorf("failed to drop full-text table: %w", err)
+		}
+	}
+
 	query := fmt.Sprintf("DROP TABLE IF EXISTS %s", dialect.QuoteIdentifier(tableName))
 
 	if _, err := db.ExecContext(ctx, query); err != nil {
@@ -113,13 +138,11 @@ func dropTable(ctx context.Context, db *sql.DB, dialect core.Dialect, model inte
 // buildColumnDefinition constrói a definição de uma coluna a partir de um campo.
 func buildColumnDefinition(field reflect.StructField, dialect core.Dialect) string {
 	// Obter tag db
-	dbTag := field.Tag.Get("db")
-	if dbTag == "" || dbTag == "-" {
+	columnName, _ := core.ParseTag(field.Tag.Get("db"))
+	if columnName == "" || columnName == "-" {
 		return ""
 	}
 
-	columnName := dbTag
-
 	// Obter tipo SQL
 	sqlType := getSQLType(field.Type, dialect)
 
@@ -174,12 +197,25 @@ func buildColumnDefinition(field reflect.StructField, dialect core.Dialect) stri
 // getSQLType retorna o tipo SQL para um tipo Go.
 func getSQLType(t reflect.Type, dialect core.Dialect) string {
 	// Verificar se é Optional[T]
-	if t.Kind() == reflect.Struct && t.Name() == "Optional" {
+	if isOptional(t) {
 		// Obter tipo interno
 		if t.NumField() > 0 {
-			innerType := t.Field(0).Type
-			return dialect.GetType(innerType.String())
+			return getSQLType(t.Field(0).Type, dialect)
+		}
+	}
+
+	// core.JSON[T] usa o tipo JSON do dialeto
+	if isCoreGeneric(t, "JSON") {
+		return dialect.GetType("json")
+	}
+
+	// core.Array[T] vira um array do tipo do elemento (ex: BIGINT[]) no PostgreSQL.
+	// Os demais bancos não têm arrays: o valor é guardado como texto ({a,b}).
+	if isCoreGeneric(t, "Array") {
+		if dialect.Name() == "postgres" {
+			return getSQLType(t.Elem(), dialect) + "[]"
 		}
+		return "TEXT"
 	}
 
 	// Mapear tipos básicos
@@ -208,7 +244,13 @@ func getSQLType(t reflect.Type, dialect core.Dialect) string {
 
 // isOptional verifica se um tipo é Optional[T].
 func isOptional(t reflect.Type) bool {
-	return t.Kind() == reflect.Struct && t.Name() == "Optional"
+	return isCoreGeneric(t, "Optional")
+}
+
+// isCoreGeneric verifica se t é uma instância do tipo genérico core.<name>[T].
+// O reflect inclui os argumentos de tipo no nome (ex: "Optional[int]").
+func isCoreGeneric(t reflect.Type, name string) bool {
+	return t.PkgPath() == corePkgPath && strings.HasPrefix(t.Name(), name+"[")
 }
 
 // getTableName obtém o nome da tabela a partir do modelo.
@@ -0,0 +1,117 @@
+package migrate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/query"
+)
+
+// fullTextIndex reúne as colunas marcadas com a opção fulltext na tag db.
+type fullTextIndex struct {
+	columns  []string
+	language string
+}
+
+// add registra o campo se ele tiver a opção fulltext.
+func (idx *fullTextIndex) add(field reflect.StructField) {
+	columnName, opts := core.ParseTag(field.Tag.Get("db"))
+	if !opts.FullText || columnName == "" || columnName == "-" {
+		return
+	}
+
+	idx.columns = append(idx.columns, columnName)
+	if idx.language == "" {
+		idx.language = opts.FullTextLanguage
+	}
+}
+
+// indexName retorna o nome do índice full-text da tabela.
+func (idx *fullTextIndex) indexName(tableName string) string {
+	return fmt.Sprintf("idx_%s_fulltext", tableName)
+}
+
+// mysqlDefinition retorna a declaração do índice FULLTEXT para o CREATE TABLE.
+func (idx *fullTextIndex) mysqlDefinition(dialect core.Dialect, tableName string) string {
+	return fmt.Sprintf("FULLTEXT KEY %s (%s)",
+		dialect.QuoteIdentifier(idx.indexName(tableName)),
+		idx.quotedColumns(dialect, ", "))
+}
+
+// statements retorna os comandos executados após o CREATE TABLE:
+//   - PostgreSQL: índice GIN sobre a mesma expressão to_tsvector usada por query.Search
+//   - SQLite: tabela virtual FTS5 de conteúdo externo e triggers que a mantêm atualizada
+func (idx *fullTextIndex) statements(dialect core.Dialect, tableName string) []string {
+	if len(idx.columns) == 0 {
+		return nil
+	}
+
+	switch dialect.Name() {
+	case "postgres":
+		return []string{fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING GIN (%s)",
+			dialect.QuoteIdentifier(idx.indexName(tableName)),
+			dialect.QuoteIdentifier(tableName),
+			query.TSVector(idx.language, idx.columns...))}
+
+	case "sqlite":
+		return idx.sqliteStatements(dialect, tableName)
+
+	default:
+		return nil
+	}
+}
+
+// sqliteStatements cria a tabela FTS5 "<tabela>_fts" (content=<tabela>, rowid=id)
+// e os triggers de INSERT, UPDATE e DELETE.
+func (idx *fullTextIndex) sqliteStatements(dialect core.Dialect, tableName string) []string {
+	table := dialect.QuoteIdentifier(tableName)
+	fts := dialect.QuoteIdentifier(query.FTSTable(tableName))
+	columns := idx.quotedColumns(dialect, ", ")
+
+	newValues := make([]string, len(idx.columns))
+	oldValues := make([]string, len(idx.columns))
+	for i, column := range idx.columns {
+		newValues[i] = "new." + dialect.QuoteIdentifier(column)
+		oldValues[i] = "old." + dialect.QuoteIdentifier(column)
+	}
+
+	insertNew := fmt.Sprintf("INSERT INTO %s(rowid, %s) VALUES (new.id, %s);",
+		fts, columns, strings.Join(newValues, ", "))
+	deleteOld := fmt.Sprintf("INSERT INTO %s(%s, rowid, %s) VALUES ('delete', old.id, %s);",
+		fts, fts, columns, strings.Join(oldValues, ", "))
+
+	trigger := func(suffix, event, body string) string {
+		name := dialect.QuoteIdentifier(fmt.Sprintf("%s_%s", query.FTSTable(tableName), suffix))
+		return fmt.Sprintf("CREATE TRIGGER IF NOT EXISTS %s AFTER %s ON %s BEGIN %s END",
+			name, event, table, body)
+	}
+
+	return []string{
+		fmt.Sprintf("CREATE VIRTUAL TABLE IF NOT EXISTS %s USING fts5(%s, content=%s, content_rowid='id')",
+			fts, columns, quoteSQLString(tableName)),
+		trigger("ai", "INSERT", insertNew),
+		trigger("ad", "DELETE", deleteOld),
+		trigger("au", "UPDATE", deleteOld+" "+insertNew),
+	}
+}
+
+// dropFTSTable retorna o comando que remove a tabela FTS5 de uma tabela do SQLite.
+func dropFTSTable(dialect core.Dialect, tableName string) string {
+	return fmt.Sprintf("DROP TABLE IF EXISTS %s", dialect.QuoteIdentifier(query.FTSTable(tableName)))
+}
+
+// quotedColumns retorna as colunas com aspas, separadas por sep.
+func (idx *fullTextIndex) quotedColumns(dialect core.Dialect, sep string) string {
+	quoted := make([]string, len(idx.columns))
+	for i, column := range idx.columns {
+		quoted[i] = dialect.QuoteIdentifier(column)
+	}
+	return strings.Join(quoted, sep)
+}
+
+// quoteSQLString escapa s como um literal de string SQL.
+func quoteSQLString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
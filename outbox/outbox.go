@@ -0,0 +1,187 @@
+// Package outbox implementa o padrão transactional outbox.
+//
+// Eventos são gravados na tabela outbox_messages na mesma transação da escrita
+// que os gerou, e um Relay os entrega depois a um Publisher (Kafka, NATS, SQS...),
+// com retentativas. Assim um evento só é publicado se a transação foi confirmada,
+// sem transação distribuída.
+//
+// Exemplo:
+//
+//	migrator.Register(outbox.Migration(20240101000000))
+//
+//	err := g.WithTx(ctx, func(tx *genus.Genus) error {
+//	    if err := tx.DB().Create(ctx, order); err != nil {
+//	        return err
+//	    }
+//	    return outbox.Enqueue(ctx, tx.DB(), outbox.Event{Topic: "orders.created", Key: "42", Payload: order})
+//	})
+//
+//	relay := outbox.NewRelay(g.DB(), publisher, outbox.RelayConfig{})
+//	go relay.Run(ctx)
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/migrate"
+	"github.com/GabrielOnRails/genus/query"
+)
+
+// TableName é a tabela onde os eventos são gravados.
+const TableName = "outbox_messages"
+
+// Event é um evento a ser publicado.
+type Event struct {
+	// Topic é o destino do evento (tópico, fila, subject...).
+	Topic string
+	// Key identifica a entidade do evento; brokers com partições a usam para ordenação.
+	Key string
+	// Payload é serializado em JSON.
+	Payload interface{}
+	// Headers são metadados opcionais (ex: trace id).
+	Headers map[string]string
+}
+
+// Message é um evento gravado no outbox.
+type Message struct {
+	ID          int64                        `db:"id"`
+	Topic       string                       `db:"topic"`
+	Key         string                       `db:"message_key"`
+	Payload     core.JSON[json.RawMessage]   `db:"payload"`
+	Headers     core.JSON[map[string]string] `db:"headers"`
+	Attempts    int                          `db:"attempts"`
+	LastError   core.Optional[string]        `db:"last_error"`
+	AvailableAt time.Time                    `db:"available_at"`
+	PublishedAt core.Optional[time.Time]     `db:"published_at"`
+	CreatedAt   time.Time                    `db:"created_at"`
+}
+
+// TableName implementa core.TableNamer.
+func (Message) TableName() string {
+	return TableName
+}
+
+// Fields são os campos tipados de Message.
+var Fields = struct {
+	ID          query.Int64Field
+	Topic       query.StringField
+	Key         query.StringField
+	Attempts    query.IntField
+	AvailableAt query.TimeField
+	PublishedAt query.OptionalTimeField
+	CreatedAt   query.TimeField
+}{
+	ID:          query.NewInt64Field("id"),
+	Topic:       query.NewStringField("topic"),
+	Key:         query.NewStringField("message_key"),
+	Attempts:    query.NewIntField("attempts"),
+	AvailableAt: query.NewTimeField("available_at"),
+	PublishedAt: query.NewOptionalTimeField("published_at"),
+	CreatedAt:   query.NewTimeField("created_at"),
+}
+
+// Migration cria a tabela do outbox.
+func Migration(version int64) migrate.Migration {
+	return migrate.CreateTableMigration(version, "create_outbox_messages", Message{})
+}
+
+// Query retorna um builder sobre a tabela do outbox (ex: para monitorar pendências).
+func Query(db *core.DB) *query.Builder[Message] {
+	return query.NewBuilder[Message](db.Executor(), db.Dialect(), db.Logger(), TableName)
+}
+
+// Enqueue grava os eventos no outbox usando db. Para que o evento só seja
+// publicado se a escrita for confirmada, chame-o com o *core.DB da transação
+// (dentro de WithTx).
+func Enqueue(ctx context.Context, db *core.DB, events ...Event) error {
+	for _, event := range events {
+		if err := enqueue(ctx, db, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueue grava um evento com um INSERT direto no executor, sem passar pelos observers.
+func enqueue(ctx context.Context, db *core.DB, event Event) error {
+	if event.Topic == "" {
+		return fmt.Errorf("outbox event has no topic")
+	}
+
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox payload: %w", err)
+	}
+	headers, err := core.NewJSON(event.Headers).Value()
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox headers: %w", err)
+	}
+
+	now := time.Now().UTC()
+	dialect := db.Dialect()
+	columns := []string{"topic", "message_key", "payload", "headers", "attempts", "available_at", "created_at"}
+	args := []interface{}{event.Topic, event.Key, string(payload), headers, 0, now, now}
+
+	placeholders := make([]string, len(columns))
+	for i := range columns {
+		placeholders[i] = dialect.Placeholder(i + 1)
+	}
+
+	insert := fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s)",
+		dialect.QuoteIdentifier(TableName),
+		strings.Join(columns, ", "),
+		strings.Join(placeholders, ", "),
+	)
+
+	start := time.Now()
+	_, err = db.Executor().ExecContext(ctx, insert, args...)
+	duration := time.Since(start).Nanoseconds()
+
+	if err != nil {
+		db.Logger().LogError(insert, args, err)
+		return fmt.Errorf("failed to enqueue outbox event: %w", err)
+	}
+
+	db.Logger().LogQuery(insert, args, duration)
+	db.InvalidateTable(TableName)
+	return nil
+}
+
+// Producer é implementado por modelos que geram eventos ao serem escritos.
+// Com Enable, os eventos retornados são gravados na transação da escrita.
+//
+// Exemplo:
+//
+//	func (o *Order) OutboxEvents(op core.Operation) []outbox.Event {
+//	    if op != core.OperationCreate {
+//	        return nil
+//	    }
+//	    return []outbox.Event{{Topic: "orders.created", Key: strconv.FormatInt(o.ID, 10), Payload: o}}
+//	}
+type Producer interface {
+	OutboxEvents(op core.Operation) []Event
+}
+
+// observer grava os eventos dos modelos que implementam Producer.
+type observer struct{}
+
+// Enable grava automaticamente os eventos dos modelos que implementam Producer
+// em cada Create, Update e Delete de db, na mesma transação.
+func Enable(db *core.DB) {
+	db.Observe(observer{})
+}
+
+// ObserveChange implementa core.ChangeObserver.
+func (observer) ObserveChange(ctx context.Context, tx *core.DB, change core.Change) error {
+	producer, ok := change.Model.(Producer)
+	if !ok {
+		return nil
+	}
+	return Enqueue(ctx, tx, producer.OutboxEvents(change.Op)...)
+}
@@ -0,0 +1,190 @@
+package outbox_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+	"github.com/GabrielOnRails/genus/migrate"
+	"github.com/GabrielOnRails/genus/outbox"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Order gera eventos do outbox ao ser criado ou removido. Sem Topic, o
+// evento é inválido.
+type Order struct {
+	core.Model
+	Total int    `db:"total"`
+	Topic string `db:"topic"`
+}
+
+// OutboxEvents implementa outbox.Producer.
+func (o *Order) OutboxEvents(op core.Operation) []outbox.Event {
+	if op == core.OperationUpdate {
+		return nil
+	}
+	topic := ""
+	if o.Topic != "" {
+		topic = o.Topic + "." + string(op)
+	}
+	return []outbox.Event{{
+		Topic:   topic,
+		Key:     strconv.FormatInt(o.ID, 10),
+		Payload: map[string]int{"total": o.Total},
+	}}
+}
+
+// silentLogger descarta as queries.
+type silentLogger struct{}
+
+func (silentLogger) LogQuery(query string, args []interface{}, duration int64) {}
+
+func (silentLogger) LogError(query string, args []interface{}, err error) {}
+
+// setupOutboxDB cria um banco SQLite em memória com as tabelas order e outbox_messages.
+func setupOutboxDB(t *testing.T) (*core.DB, *sql.DB) {
+	t.Helper()
+
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if _, err := sqlDB.Exec(`CREATE TABLE "order" (id INTEGER PRIMARY KEY AUTOINCREMENT, total INTEGER, topic TEXT, created_at DATETIME, updated_at DATETIME)`); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	if err := migrate.AutoMigrate(context.Background(), sqlDB, sqlite.New(), outbox.Message{}); err != nil {
+		t.Fatalf("Failed to create outbox table: %v", err)
+	}
+
+	return core.NewWithLogger(sqlDB, sqlite.New(), silentLogger{}), sqlDB
+}
+
+// messages retorna as mensagens do outbox em ordem de ID.
+func messages(t *testing.T, db *core.DB) []outbox.Message {
+	t.Helper()
+
+	result, err := outbox.Query(db).OrderBy(outbox.Fields.ID.Asc()).Find(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to read outbox: %v", err)
+	}
+	return result
+}
+
+func TestEnqueueInTransaction(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupOutboxDB(t)
+	ctx := context.Background()
+	event := outbox.Event{
+		Topic:   "orders.created",
+		Key:     "42",
+		Payload: map[string]int{"total": 100},
+		Headers: map[string]string{"trace": "abc"},
+	}
+
+	// Rollback da transação descarta o evento junto com a escrita
+	rollback := errors.New("rollback")
+	err := db.WithTx(ctx, func(tx *core.DB) error {
+		if err := tx.Create(ctx, &Order{Total: 100}); err != nil {
+			return err
+		}
+		if err := outbox.Enqueue(ctx, tx, event); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("Expected rollback error, got %v", err)
+	}
+	if got := messages(t, db); len(got) != 0 {
+		t.Fatalf("Expected no messages after rollback, got %d", len(got))
+	}
+
+	err = db.WithTx(ctx, func(tx *core.DB) error {
+		if err := tx.Create(ctx, &Order{Total: 100}); err != nil {
+			return err
+		}
+		return outbox.Enqueue(ctx, tx, event)
+	})
+	if err != nil {
+		t.Fatalf("Transaction failed: %v", err)
+	}
+
+	got := messages(t, db)
+	if len(got) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(got))
+	}
+	message := got[0]
+	if message.Topic != "orders.created" || message.Key != "42" || message.Attempts != 0 {
+		t.Errorf("Unexpected message: %+v", message)
+	}
+	if string(message.Payload.Data) != `{"total":100}` {
+		t.Errorf("Unexpected payload: %s", message.Payload.Data)
+	}
+	if message.Headers.Data["trace"] != "abc" {
+		t.Errorf("Unexpected headers: %v", message.Headers.Data)
+	}
+	if message.PublishedAt.IsPresent() {
+		t.Error("Expected message to be pending")
+	}
+
+	if err := outbox.Enqueue(ctx, db, outbox.Event{Payload: 1}); err == nil {
+		t.Error("Expected event without topic to be rejected")
+	}
+}
+
+func TestProducerObserver(t *testing.T) {
+	t.Parallel()
+
+	db, sqlDB := setupOutboxDB(t)
+	outbox.Enable(db)
+	ctx := context.Background()
+
+	order := &Order{Total: 100, Topic: "orders"}
+	if err := db.Create(ctx, order); err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
+	order.Total = 200
+	if err := db.Update(ctx, order); err != nil {
+		t.Fatalf("Failed to update order: %v", err)
+	}
+	if err := db.Delete(ctx, order); err != nil {
+		t.Fatalf("Failed to delete order: %v", err)
+	}
+
+	got := messages(t, db)
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 messages, got %d", len(got))
+	}
+	if got[0].Topic != "orders.create" || got[1].Topic != "orders.delete" {
+		t.Errorf("Unexpected topics: %s, %s", got[0].Topic, got[1].Topic)
+	}
+	// O evento do Create já tem o ID gerado pelo banco
+	if got[0].Key != strconv.FormatInt(order.ID, 10) {
+		t.Errorf("Expected key %d, got %s", order.ID, got[0].Key)
+	}
+	var payload map[string]int
+	if err := json.Unmarshal(got[1].Payload.Data, &payload); err != nil || payload["total"] != 200 {
+		t.Errorf("Unexpected delete payload: %s", got[1].Payload.Data)
+	}
+
+	// Um evento inválido desfaz a escrita que o gerou
+	if err := db.Create(ctx, &Order{Total: 1}); err == nil {
+		t.Fatal("Expected order with an invalid event to fail")
+	}
+	var orders int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM "order"`).Scan(&orders); err != nil {
+		t.Fatalf("Failed to count orders: %v", err)
+	}
+	if orders != 0 {
+		t.Errorf("Expected create to be rolled back, got %d orders", orders)
+	}
+}
@@ -0,0 +1,207 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GabrielOnRails/genus/core"
+)
+
+// Publisher entrega uma mensagem do outbox ao broker.
+// Um erro faz a mensagem ser tentada novamente mais tarde; como a entrega é
+// at-least-once, consumidores devem ser idempotentes (use Message.ID).
+type Publisher interface {
+	Publish(ctx context.Context, message Message) error
+}
+
+// PublisherFunc adapta uma função para Publisher.
+type PublisherFunc func(ctx context.Context, message Message) error
+
+// Publish implementa Publisher.
+func (f PublisherFunc) Publish(ctx context.Context, message Message) error {
+	return f(ctx, message)
+}
+
+// RelayConfig configura o Relay. Campos zerados usam os valores padrão.
+type RelayConfig struct {
+	// BatchSize é o número máximo de mensagens por lote (padrão 100).
+	BatchSize int
+	// PollInterval é o intervalo entre consultas quando não há mensagens (padrão 1s).
+	PollInterval time.Duration
+	// MaxAttempts é o número de tentativas antes de desistir da mensagem (padrão 10).
+	// Mensagens que esgotaram as tentativas ficam no outbox com LastError preenchido.
+	MaxAttempts int
+	// Backoff retorna a espera antes da próxima tentativa, dado o número de
+	// tentativas já feitas (padrão exponencial: 1s, 2s, 4s, ... até 1h).
+	Backoff func(attempts int) time.Duration
+	// OnError recebe os erros de lotes que falharam em Run (ex: banco indisponível).
+	// O padrão registra o erro com o Logger do DB.
+	OnError func(err error)
+}
+
+// Relay lê as mensagens pendentes do outbox e as entrega ao Publisher.
+// Vários relays podem rodar em paralelo: as mensagens de cada lote são
+// bloqueadas com FOR UPDATE SKIP LOCKED, então cada uma é entregue por um só relay.
+type Relay struct {
+	db        *core.DB
+	publisher Publisher
+	config    RelayConfig
+}
+
+// NewRelay cria um relay para o outbox de db.
+func NewRelay(db *core.DB, publisher Publisher, config RelayConfig) *Relay {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 100
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = time.Second
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 10
+	}
+	if config.Backoff == nil {
+		config.Backoff = exponentialBackoff
+	}
+	if config.OnError == nil {
+		config.OnError = func(err error) {
+			db.Logger().LogError("outbox relay", nil, err)
+		}
+	}
+
+	return &Relay{
+		db:        db,
+		publisher: publisher,
+		config:    config,
+	}
+}
+
+// Run processa lotes até ctx ser cancelado, esperando PollInterval quando
+// não há mensagens ou quando um lote falha. Erros de lote são passados a
+// RelayConfig.OnError. Retorna ctx.Err().
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		processed, err := r.ProcessBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.config.OnError(err)
+		}
+		if err == nil && processed == r.config.BatchSize {
+			// Lote cheio: provavelmente há mais mensagens pendentes
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.config.PollInterval):
+		}
+	}
+}
+
+// ProcessBatch entrega um lote de mensagens pendentes, em uma transação.
+// Retorna quantas mensagens foram processadas (entregues ou reagendadas).
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	processed := 0
+
+	err := r.db.WithTx(ctx, func(tx *core.DB) error {
+		messages, err := r.lockPending(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			if err := r.publisher.Publish(ctx, message); err != nil {
+				if err := r.markFailed(ctx, tx, message, err); err != nil {
+					return err
+				}
+			} else if err := r.markPublished(ctx, tx, message); err != nil {
+				return err
+			}
+			processed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to process outbox batch: %w", err)
+	}
+
+	return processed, nil
+}
+
+// lockPending seleciona e bloqueia as mensagens prontas para entrega.
+// O SQLite não tem bloqueio de linhas: a transação adquire o lock de escrita
+// do banco antes do SELECT, o que serializa os relays.
+func (r *Relay) lockPending(ctx context.Context, tx *core.DB) ([]Message, error) {
+	pending := Query(tx).
+		Where(Fields.PublishedAt.IsNull()).
+		Where(Fields.Attempts.Lt(r.config.MaxAttempts)).
+		Where(Fields.AvailableAt.Lte(time.Now().UTC())).
+		OrderBy(Fields.ID.Asc()).
+		Limit(r.config.BatchSize)
+
+	if tx.Dialect().Name() == "sqlite" {
+		lock := fmt.Sprintf("UPDATE %s SET attempts = attempts WHERE 1 = 0", tx.Dialect().QuoteIdentifier(TableName))
+		if _, err := tx.Executor().ExecContext(ctx, lock); err != nil {
+			tx.Logger().LogError(lock, nil, err)
+			return nil, fmt.Errorf("failed to lock outbox: %w", err)
+		}
+	} else {
+		pending = pending.ForUpdate().SkipLocked()
+	}
+
+	return pending.Find(ctx)
+}
+
+// markPublished marca a mensagem como entregue.
+func (r *Relay) markPublished(ctx context.Context, tx *core.DB, message Message) error {
+	return r.exec(ctx, tx, "published_at = %s, attempts = %s", time.Now().UTC(), message.Attempts+1, message.ID)
+}
+
+// markFailed registra a falha e reagenda a mensagem conforme o Backoff.
+func (r *Relay) markFailed(ctx context.Context, tx *core.DB, message Message, publishErr error) error {
+	attempts := message.Attempts + 1
+	availableAt := time.Now().UTC().Add(r.config.Backoff(attempts))
+	return r.exec(ctx, tx, "attempts = %s, last_error = %s, available_at = %s", attempts, publishErr.Error(), availableAt, message.ID)
+}
+
+// exec executa UPDATE outbox_messages SET <set> WHERE id = ?.
+// set contém um %s para cada placeholder; o último argumento é o ID.
+func (r *Relay) exec(ctx context.Context, tx *core.DB, set string, args ...interface{}) error {
+	dialect := tx.Dialect()
+	placeholders := make([]interface{}, len(args)-1)
+	for i := range placeholders {
+		placeholders[i] = dialect.Placeholder(i + 1)
+	}
+
+	update := fmt.Sprintf(
+		"UPDATE %s SET %s WHERE id = %s",
+		dialect.QuoteIdentifier(TableName),
+		fmt.Sprintf(set, placeholders...),
+		dialect.Placeholder(len(args)),
+	)
+
+	start := time.Now()
+	_, err := tx.Executor().ExecContext(ctx, update, args...)
+	duration := time.Since(start).Nanoseconds()
+
+	if err != nil {
+		tx.Logger().LogError(update, args, err)
+		return fmt.Errorf("failed to update outbox message: %w", err)
+	}
+
+	tx.Logger().LogQuery(update, args, duration)
+	tx.InvalidateTable(TableName)
+	return nil
+}
+
+// exponentialBackoff dobra a espera a cada tentativa, de 1s até 1h.
+func exponentialBackoff(attempts int) time.Duration {
+	wait := time.Second
+	for i := 1; i < attempts && wait < time.Hour; i++ {
+		wait *= 2
+	}
+	if wait > time.Hour {
+		wait = time.Hour
+	}
+	return wait
+}
@@ -0,0 +1,162 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GabrielOnRails/genus/outbox"
+)
+
+// recordingPublisher guarda as mensagens publicadas e falha enquanto err não é nil.
+type recordingPublisher struct {
+	err       error
+	published []outbox.Message
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, message outbox.Message) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, message)
+	return nil
+}
+
+func TestProcessBatchPublishes(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupOutboxDB(t)
+	ctx := context.Background()
+	for _, topic := range []string{"a", "b", "c"} {
+		if err := outbox.Enqueue(ctx, db, outbox.Event{Topic: topic, Payload: topic}); err != nil {
+			t.Fatalf("Failed to enqueue: %v", err)
+		}
+	}
+
+	publisher := &recordingPublisher{}
+	relay := outbox.NewRelay(db, publisher, outbox.RelayConfig{BatchSize: 2})
+
+	for _, want := range []int{2, 1, 0} {
+		processed, err := relay.ProcessBatch(ctx)
+		if err != nil {
+			t.Fatalf("ProcessBatch failed: %v", err)
+		}
+		if processed != want {
+			t.Errorf("Expected %d messages processed, got %d", want, processed)
+		}
+	}
+
+	if len(publisher.published) != 3 || publisher.published[0].Topic != "a" || publisher.published[2].Topic != "c" {
+		t.Errorf("Expected a, b and c to be published in order, got %+v", publisher.published)
+	}
+	for _, message := range messages(t, db) {
+		if !message.PublishedAt.IsPresent() || message.Attempts != 1 {
+			t.Errorf("Expected message %d to be published after 1 attempt, got %+v", message.ID, message)
+		}
+	}
+}
+
+func TestProcessBatchRetries(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupOutboxDB(t)
+	ctx := context.Background()
+	if err := outbox.Enqueue(ctx, db, outbox.Event{Topic: "a"}); err != nil {
+		t.Fatalf("Failed to enqueue: %v", err)
+	}
+
+	var backoffs []int
+	publisher := &recordingPublisher{err: errors.New("broker down")}
+	relay := outbox.NewRelay(db, publisher, outbox.RelayConfig{
+		Backoff: func(attempts int) time.Duration {
+			backoffs = append(backoffs, attempts)
+			return time.Hour
+		},
+	})
+
+	before := time.Now().UTC()
+	if processed, err := relay.ProcessBatch(ctx); err != nil || processed != 1 {
+		t.Fatalf("Expected 1 message processed, got %d (err %v)", processed, err)
+	}
+
+	message := messages(t, db)[0]
+	if message.Attempts != 1 || message.LastError.GetOrZero() != "broker down" || message.PublishedAt.IsPresent() {
+		t.Errorf("Unexpected message after failure: %+v", message)
+	}
+	if message.AvailableAt.Before(before.Add(59 * time.Minute)) {
+		t.Errorf("Expected message to be rescheduled by the backoff, available at %v", message.AvailableAt)
+	}
+	if len(backoffs) != 1 || backoffs[0] != 1 {
+		t.Errorf("Expected backoff for attempt 1, got %v", backoffs)
+	}
+
+	// Reagendada para daqui a 1h, a mensagem não está pronta
+	publisher.err = nil
+	if processed, err := relay.ProcessBatch(ctx); err != nil || processed != 0 {
+		t.Errorf("Expected no messages ready, got %d (err %v)", processed, err)
+	}
+}
+
+func TestProcessBatchGivesUpAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupOutboxDB(t)
+	ctx := context.Background()
+	if err := outbox.Enqueue(ctx, db, outbox.Event{Topic: "a"}); err != nil {
+		t.Fatalf("Failed to enqueue: %v", err)
+	}
+
+	publisher := &recordingPublisher{err: errors.New("broker down")}
+	relay := outbox.NewRelay(db, publisher, outbox.RelayConfig{
+		MaxAttempts: 2,
+		Backoff:     func(int) time.Duration { return 0 },
+	})
+
+	for _, want := range []int{1, 1, 0} {
+		if processed, err := relay.ProcessBatch(ctx); err != nil || processed != want {
+			t.Fatalf("Expected %d messages processed, got %d (err %v)", want, processed, err)
+		}
+	}
+
+	// A mensagem fica no outbox com o último erro
+	message := messages(t, db)[0]
+	if message.Attempts != 2 || message.LastError.GetOrZero() != "broker down" || message.PublishedAt.IsPresent() {
+		t.Errorf("Unexpected message after giving up: %+v", message)
+	}
+}
+
+func TestRunReportsErrors(t *testing.T) {
+	t.Parallel()
+
+	db, sqlDB := setupOutboxDB(t)
+	if _, err := sqlDB.Exec(`DROP TABLE ` + outbox.TableName); err != nil {
+		t.Fatalf("Failed to drop outbox table: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var reported []error
+	relay := outbox.NewRelay(db, &recordingPublisher{}, outbox.RelayConfig{
+		PollInterval: time.Millisecond,
+		OnError: func(err error) {
+			reported = append(reported, err)
+			if len(reported) == 2 {
+				cancel()
+			}
+		},
+	})
+
+	// Run continua após um lote com erro e retorna quando ctx é cancelado
+	if err := relay.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if len(reported) != 2 {
+		t.Fatalf("Expected 2 reported errors, got %d", len(reported))
+	}
+	if !strings.Contains(reported[0].Error(), "failed to process outbox batch") {
+		t.Errorf("Unexpected reported error: %v", reported[0])
+	}
+}
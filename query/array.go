@@ -0,0 +1,71 @@
+package query
+
+import (
+	"fmt"
+
+	"github.com/GabrielOnRails/genus/core"
+)
+
+// ArrayField representa uma coluna array do PostgreSQL (core.Array[T]),
+// com os operadores de arrays (@>, <@, &&, ANY). Disponível apenas no PostgreSQL.
+//
+// Exemplo:
+//
+//	var PostFields = struct {
+//	    Tags query.ArrayField[string]
+//	}{
+//	    Tags: query.NewArrayField[string]("tags"),
+//	}
+//
+//	// WHERE (tags @> $1) AND (cardinality(tags) <= $2)
+//	genus.Table[Post](db).Where(query.And(
+//	    PostFields.Tags.Contains("go", "orm"),
+//	    PostFields.Tags.Length().Lte(5),
+//	))
+type ArrayField[T any] struct {
+	Field[core.Array[T]]
+}
+
+func NewArrayField[T any](column string) ArrayField[T] {
+	return ArrayField[T]{NewField[core.Array[T]](column)}
+}
+
+// Contains cria a condição "o array contém todos os values" (coluna @> values).
+func (f ArrayField[T]) Contains(values ...T) Condition {
+	return f.compareArray("@>", values)
+}
+
+// ContainedBy cria a condição "todos os elementos do array estão em values" (coluna <@ values).
+func (f ArrayField[T]) ContainedBy(values ...T) Condition {
+	return f.compareArray("<@", values)
+}
+
+// Overlaps cria a condição "o array tem algum elemento em comum com values" (coluna && values).
+func (f ArrayField[T]) Overlaps(values ...T) Condition {
+	return f.compareArray("&&", values)
+}
+
+// Any cria a condição "value é um dos elementos do array" (value = ANY(coluna)).
+func (f ArrayField[T]) Any(value T) Condition {
+	return Expr(fmt.Sprintf("? = ANY(%s)", f.column), value)
+}
+
+// Length retorna o número de elementos do array (cardinality) como campo ordenável.
+// Arrays vazios têm tamanho 0.
+//
+// Exemplo:
+//
+//	PostFields.Tags.Length().Between(1, 5)
+func (f ArrayField[T]) Length() OrderedField[int] {
+	return NewOrderedField[int](fmt.Sprintf("cardinality(%s)", f.column))
+}
+
+// IsEmpty cria a condição "o array não tem elementos".
+func (f ArrayField[T]) IsEmpty() Condition {
+	return f.Length().Eq(0)
+}
+
+// compareArray cria a condição "coluna op values" com values codificado como array.
+func (f ArrayField[T]) compareArray(op string, values []T) Condition {
+	return Expr(fmt.Sprintf("%s %s ?", f.column, op), core.Array[T](values))
+}
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -12,85 +13,169 @@ import (
 // Builder é o query builder genérico type-safe.
 // T é o tipo do modelo sendo consultado.
 type Builder[T any] struct {
+	builderState
+}
+
+// builderState contém o estado do builder que não depende de T.
+// Separado para que o estado possa ser copiado entre builders de tipos
+// diferentes (ver Project).
+type builderState struct {
 	executor   core.Executor
 	dialect    core.Dialect
 	logger     core.Logger
 	tableName  string
-	conditions []interface{} // Condition ou ConditionGroup
+	conditions []Expression
 	orderBy    []OrderBy
 	limit      *int
 	offset     *int
 	selectCols []string
-}
 
-// OrderBy representa uma cláusula ORDER BY.
-type OrderBy struct {
-	Column string
-	Desc   bool
+	// fromSubquery substitui a tabela na cláusula FROM: FROM (subquery) AS fromAlias
+	fromSubquery Subquery
+	fromAlias    string
+
+	// ctes são as common table expressions (WITH ...) da query
+	ctes []cte
+	// joins são os INNER JOINs da query
+	joins []join
+
+	// lockMode e lockWait definem o bloqueio de linhas (FOR UPDATE / FOR SHARE)
+	lockMode LockMode
+	lockWait LockWait
+
+	// model é o tipo do modelo, usado para buscar os scopes globais (ver AddDefaultScope)
+	model reflect.Type
+	// unscoped desativa os scopes globais; skipScopes desativa apenas os nomeados
+	unscoped   bool
+	skipScopes []string
+
+	// tenant é o tenant definido com ForTenant; tenantApplied indica que o
+	// filtro de tenant já foi aplicado ao builder e às suas subqueries
+	tenant        *tenantFilter
+	tenantApplied bool
+	// tenantResolver resolve o tenant do contexto (ver UseTenantResolver)
+	tenantResolver core.TenantResolver
+
+	// cache e cacheTTL definem o cache de resultados (ver Cache)
+	cache    core.Cache
+	cacheTTL time.Duration
+
+	// err guarda um erro de construção, retornado ao executar a query
+	err error
 }
 
 // NewBuilder cria um novo query builder.
 func NewBuilder[T any](executor core.Executor, dialect core.Dialect, logger core.Logger, tableName string) *Builder[T] {
 	return &Builder[T]{
-		executor:  executor,
-		dialect:   dialect,
-		logger:    logger,
-		tableName: tableName,
+		builderState: builderState{
+			executor:  executor,
+			dialect:   dialect,
+			logger:    logger,
+			tableName: tableName,
+			model:     modelType[T](),
+		},
 	}
 }
 
 // clone cria uma cópia profunda do builder para garantir imutabilidade.
 // Cada método que modifica o estado retorna um novo builder.
 func (b *Builder[T]) clone() *Builder[T] {
-	newBuilder := &Builder[T]{
-		executor:  b.executor,
-		dialect:   b.dialect,
-		logger:    b.logger,
-		tableName: b.tableName,
+	return &Builder[T]{builderState: b.builderState.clone()}
+}
+
+// clone cria uma cópia profunda do estado.
+func (s builderState) clone() builderState {
+	newState := builderState{
+		executor:       s.executor,
+		dialect:        s.dialect,
+		logger:         s.logger,
+		tableName:      s.tableName,
+		fromSubquery:   s.fromSubquery,
+		fromAlias:      s.fromAlias,
+		lockMode:       s.lockMode,
+		lockWait:       s.lockWait,
+		model:          s.model,
+		unscoped:       s.unscoped,
+		tenant:         s.tenant,
+		tenantApplied:  s.tenantApplied,
+		tenantResolver: s.tenantResolver,
+		cache:          s.cache,
+		cacheTTL:       s.cacheTTL,
+		err:            s.err,
+	}
+
+	// Copiar skipScopes
+	if len(s.skipScopes) > 0 {
+		newState.skipScopes = make([]string, len(s.skipScopes))
+		copy(newState.skipScopes, s.skipScopes)
 	}
 
 	// Copiar conditions
-	if len(b.conditions) > 0 {
-		newBuilder.conditions = make([]interface{}, len(b.conditions))
-		copy(newBuilder.conditions, b.conditions)
+	if len(s.conditions) > 0 {
+		newState.conditions = make([]Expression, len(s.conditions))
+		copy(newState.conditions, s.conditions)
 	}
 
 	// Copiar orderBy
-	if len(b.orderBy) > 0 {
-		newBuilder.orderBy = make([]OrderBy, len(b.orderBy))
-		copy(newBuilder.orderBy, b.orderBy)
+	if len(s.orderBy) > 0 {
+		newState.orderBy = make([]OrderBy, len(s.orderBy))
+		copy(newState.orderBy, s.orderBy)
 	}
 
 	// Copiar limit
-	if b.limit != nil {
-		limitVal := *b.limit
-		newBuilder.limit = &limitVal
+	if s.limit != nil {
+		limitVal := *s.limit
+		newState.limit = &limitVal
 	}
 
 	// Copiar offset
-	if b.offset != nil {
-		offsetVal := *b.offset
-		newBuilder.offset = &offsetVal
+	if s.offset != nil {
+		offsetVal := *s.offset
+		newState.offset = &offsetVal
 	}
 
 	// Copiar selectCols
-	if len(b.selectCols) > 0 {
-		newBuilder.selectCols = make([]string, len(b.selectCols))
-		copy(newBuilder.selectCols, b.selectCols)
+	if len(s.selectCols) > 0 {
+		newState.selectCols = make([]string, len(s.selectCols))
+		copy(newState.selectCols, s.selectCols)
 	}
 
-	return newBuilder
+	// Copiar ctes
+	if len(s.ctes) > 0 {
+		newState.ctes = make([]cte, len(s.ctes))
+		copy(newState.ctes, s.ctes)
+	}
+
+	// Copiar joins
+	if len(s.joins) > 0 {
+		newState.joins = make([]join, len(s.joins))
+		copy(newState.joins, s.joins)
+	}
+
+	return newState
 }
 
 // Where adiciona uma condição WHERE.
-// Aceita Condition ou ConditionGroup.
+// Aceita Condition, ConditionGroup (And/Or) ou uma expressão negada (Not).
 // IMUTÁVEL: Retorna um novo builder sem modificar o original.
-func (b *Builder[T]) Where(condition interface{}) *Builder[T] {
+func (b *Builder[T]) Where(condition Expression) *Builder[T] {
 	newBuilder := b.clone()
 	newBuilder.conditions = append(newBuilder.conditions, condition)
 	return newBuilder
 }
 
+// OrderBy adiciona ordenações a partir de campos tipados.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+//
+// Exemplo:
+//
+//	builder.OrderBy(UserFields.Age.Desc().NullsLast(), UserFields.Name.Asc())
+func (b *Builder[T]) OrderBy(orders ...OrderBy) *Builder[T] {
+	newBuilder := b.clone()
+	newBuilder.orderBy = append(newBuilder.orderBy, orders...)
+	return newBuilder
+}
+
 // OrderByAsc adiciona ORDER BY ASC.
 // IMUTÁVEL: Retorna um novo builder sem modificar o original.
 func (b *Builder[T]) OrderByAsc(column string) *Builder[T] {
@@ -131,17 +216,83 @@ func (b *Builder[T]) Select(columns ...string) *Builder[T] {
 	return newBuilder
 }
 
+// SelectFields define as colunas a serem selecionadas usando campos tipados.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+//
+// Colunas não selecionadas ficam com o valor zero em T. Para carregar apenas
+// um subconjunto das colunas em outro tipo, use Project.
+func (b *Builder[T]) SelectFields(fields ...Column) *Builder[T] {
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		columns[i] = field.ColumnName()
+	}
+	return b.Select(columns...)
+}
+
+// Project converte o builder de T em um builder de R, mantendo tabela, condições,
+// ordenação e paginação. As colunas selecionadas são derivadas das tags db de R,
+// então Find retorna []R apenas com as colunas necessárias.
+//
+// Exemplo:
+//
+//	type UserSummary struct {
+//	    ID   int64  `db:"id"`
+//	    Name string `db:"name"`
+//	}
+//
+//	summaries, err := query.Project[User, UserSummary](
+//	    genus.Table[User](db).Where(UserFields.IsActive.Eq(true)),
+//	).Find(ctx) // SELECT id, name FROM "users" WHERE is_active = $1
+func Project[T any, R any](b *Builder[T]) *Builder[R] {
+	var r R
+	projected := &Builder[R]{builderState: b.builderState.clone()}
+	projected.selectCols = structColumns(reflect.TypeOf(r))
+
+	return projected
+}
+
 // Find executa a query e retorna um slice de T.
 // Esta é a função mágica que retorna []T sem precisar de *[]T!
 func (b *Builder[T]) Find(ctx context.Context) ([]T, error) {
+	b = b.forContext(ctx)
+	if err := b.buildErr(); err != nil {
+		return nil, err
+	}
+
 	query, args := b.buildSelectQuery()
 
+	key, cacheable := b.cacheKey("find", query, args)
+	var tables []string
+	var generation uint64
+	if cacheable {
+		if cached, ok := b.cache.Get(key); ok {
+			if results, ok := cached.([]T); ok {
+				return core.DeepCopy(results), nil
+			}
+		}
+		tables = b.cacheTables()
+		generation = b.cache.Generation(tables)
+	}
+
+	results, err := findAll[T](ctx, b.executor, b.logger, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheable {
+		b.cache.Set(key, tables, core.DeepCopy(results), b.cacheTTL, generation)
+	}
+	return results, nil
+}
+
+// findAll executa uma query e escaneia todas as linhas para []T.
+func findAll[T any](ctx context.Context, executor core.Executor, logger core.Logger, query string, args []interface{}) ([]T, error) {
 	start := time.Now()
-	rows, err := b.executor.QueryContext(ctx, query, args...)
+	rows, err := executor.QueryContext(ctx, query, args...)
 	duration := time.Since(start).Nanoseconds()
 
 	if err != nil {
-		b.logger.LogError(query, args, err)
+		logger.LogError(query, args, err)
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
 	defer rows.Close()
@@ -159,7 +310,7 @@ func (b *Builder[T]) Find(ctx context.Context) ([]T, error) {
 		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
-	b.logger.LogQuery(query, args, duration)
+	logger.LogQuery(query, args, duration)
 	return results, nil
 }
 
@@ -184,8 +335,26 @@ func (b *Builder[T]) First(ctx context.Context) (T, error) {
 
 // Count retorna a contagem de registros.
 func (b *Builder[T]) Count(ctx context.Context) (int64, error) {
+	b = b.forContext(ctx)
+	if err := b.buildErr(); err != nil {
+		return 0, err
+	}
+
 	query, args := b.buildCountQuery()
 
+	key, cacheable := b.cacheKey("count", query, args)
+	var tables []string
+	var generation uint64
+	if cacheable {
+		if cached, ok := b.cache.Get(key); ok {
+			if count, ok := cached.(int64); ok {
+				return count, nil
+			}
+		}
+		tables = b.cacheTables()
+		generation = b.cache.Generation(tables)
+	}
+
 	var count int64
 	start := time.Now()
 	err := b.executor.QueryRowContext(ctx, query, args...).Scan(&count)
@@ -197,14 +366,33 @@ func (b *Builder[T]) Count(ctx context.Context) (int64, error) {
 	}
 
 	b.logger.LogQuery(query, args, duration)
+
+	if cacheable {
+		b.cache.Set(key, tables, count, b.cacheTTL, generation)
+	}
 	return count, nil
 }
 
 // buildSelectQuery constrói a query SELECT.
 func (b *Builder[T]) buildSelectQuery() (string, []interface{}) {
+	argIndex := 1
+	return b.buildSelect(&argIndex)
+}
+
+// buildSelect constrói a query SELECT numerando os placeholders a partir de argIndex.
+// Permite compor builders (subqueries) mantendo a numeração correta ($1, $2, ...).
+func (b *Builder[T]) buildSelect(argIndex *int) (string, []interface{}) {
 	var sb strings.Builder
 	var args []interface{}
 
+	// WITH
+	if len(b.ctes) > 0 {
+		withSQL, withArgs := b.buildWithClause(argIndex)
+		sb.WriteString(withSQL)
+		sb.WriteString(" ")
+		args = append(args, withArgs...)
+	}
+
 	// SELECT
 	sb.WriteString("SELECT ")
 	if len(b.selectCols) > 0 {
@@ -215,12 +403,14 @@ func (b *Builder[T]) buildSelectQuery() (string, []interface{}) {
 
 	// FROM
 	sb.WriteString(" FROM ")
-	sb.WriteString(b.dialect.QuoteIdentifier(b.tableName))
+	fromSQL, fromArgs := b.buildFrom(argIndex)
+	sb.WriteString(fromSQL)
+	args = append(args, fromArgs...)
 
 	// WHERE
 	if len(b.conditions) > 0 {
 		sb.WriteString(" WHERE ")
-		whereSQL, whereArgs := b.buildWhereClause(b.conditions)
+		whereSQL, whereArgs := b.buildWhereClause(b.conditions, argIndex)
 		sb.WriteString(whereSQL)
 		args = append(args, whereArgs...)
 	}
@@ -228,15 +418,9 @@ func (b *Builder[T]) buildSelectQuery() (string, []interface{}) {
 	// ORDER BY
 	if len(b.orderBy) > 0 {
 		sb.WriteString(" ORDER BY ")
-		orderParts := make([]string, len(b.orderBy))
-		for i, order := range b.orderBy {
-			if order.Desc {
-				orderParts[i] = order.Column + " DESC"
-			} else {
-				orderParts[i] = order.Column + " ASC"
-			}
-		}
-		sb.WriteString(strings.Join(orderParts, ", "))
+		orderSQL, orderArgs := b.buildOrderByClause(argIndex)
+		sb.WriteString(orderSQL)
+		args = append(args, orderArgs...)
 	}
 
 	// LIMIT
@@ -249,6 +433,9 @@ func (b *Builder[T]) buildSelectQuery() (string, []interface{}) {
 		sb.WriteString(fmt.Sprintf(" OFFSET %d", *b.offset))
 	}
 
+	// FOR UPDATE / FOR SHARE
+	sb.WriteString(b.buildLockClause())
+
 	return sb.String(), args
 }
 
@@ -256,13 +443,23 @@ func (b *Builder[T]) buildSelectQuery() (string, []interface{}) {
 func (b *Builder[T]) buildCountQuery() (string, []interface{}) {
 	var sb strings.Builder
 	var args []interface{}
+	argIndex := 1
+
+	if len(b.ctes) > 0 {
+		withSQL, withArgs := b.buildWithClause(&argIndex)
+		sb.WriteString(withSQL)
+		sb.WriteString(" ")
+		args = append(args, withArgs...)
+	}
 
 	sb.WriteString("SELECT COUNT(*) FROM ")
-	sb.WriteString(b.dialect.QuoteIdentifier(b.tableName))
+	fromSQL, fromArgs := b.buildFrom(&argIndex)
+	sb.WriteString(fromSQL)
+	args = append(args, fromArgs...)
 
 	if len(b.conditions) > 0 {
 		sb.WriteString(" WHERE ")
-		whereSQL, whereArgs := b.buildWhereClause(b.conditions)
+		whereSQL, whereArgs := b.buildWhereClause(b.conditions, &argIndex)
 		sb.WriteString(whereSQL)
 		args = append(args, whereArgs...)
 	}
@@ -270,33 +467,66 @@ func (b *Builder[T]) buildCountQuery() (string, []interface{}) {
 	return sb.String(), args
 }
 
+// buildFrom constrói a fonte da query: a tabela ou uma subquery com alias, seguida dos JOINs.
+func (b *Builder[T]) buildFrom(argIndex *int) (string, []interface{}) {
+	var from string
+	var args []interface{}
+
+	if b.fromSubquery != nil {
+		subSQL, subArgs := b.fromSubquery.buildSubquery(argIndex)
+		from = fmt.Sprintf("(%s) AS %s", subSQL, b.dialect.QuoteIdentifier(b.fromAlias))
+		args = subArgs
+	} else {
+		from = b.dialect.QuoteIdentifier(b.tableName)
+	}
+
+	for _, j := range b.joins {
+		from += fmt.Sprintf(" INNER JOIN %s ON %s", b.dialect.QuoteIdentifier(j.table), j.on)
+	}
+
+	return from, args
+}
+
 // buildWhereClause constrói a cláusula WHERE.
-func (b *Builder[T]) buildWhereClause(conditions []interface{}) (string, []interface{}) {
+func (b *Builder[T]) buildWhereClause(conditions []Expression, argIndex *int) (string, []interface{}) {
 	if len(conditions) == 0 {
 		return "", nil
 	}
 
 	var parts []string
 	var args []interface{}
-	argIndex := 1
 
 	for _, cond := range conditions {
-		switch c := cond.(type) {
-		case Condition:
-			sql, condArgs := b.buildCondition(c, &argIndex)
-			parts = append(parts, sql)
-			args = append(args, condArgs...)
-
-		case ConditionGroup:
-			sql, condArgs := b.buildConditionGroup(c, &argIndex)
-			parts = append(parts, "("+sql+")")
-			args = append(args, condArgs...)
-		}
+		sql, condArgs := b.buildExpression(cond, argIndex)
+		parts = append(parts, sql)
+		args = append(args, condArgs...)
 	}
 
 	return strings.Join(parts, " AND "), args
 }
 
+// buildExpression constrói uma expressão; grupos são envolvidos em parênteses.
+func (b *Builder[T]) buildExpression(expr Expression, argIndex *int) (string, []interface{}) {
+	switch e := expr.(type) {
+	case Condition:
+		return b.buildCondition(e, argIndex)
+
+	case ConditionGroup:
+		sql, args := b.buildConditionGroup(e, argIndex)
+		return "(" + sql + ")", args
+
+	case NotExpression:
+		if group, ok := e.Expression.(ConditionGroup); ok {
+			sql, args := b.buildConditionGroup(group, argIndex)
+			return "NOT (" + sql + ")", args
+		}
+		sql, args := b.buildExpression(e.Expression, argIndex)
+		return "NOT (" + sql + ")", args
+	}
+
+	return "", nil
+}
+
 // buildCondition constrói uma única condição.
 func (b *Builder[T]) buildCondition(cond Condition, argIndex *int) (string, []interface{}) {
 	var args []interface{}
@@ -308,7 +538,30 @@ func (b *Builder[T]) buildCondition(cond Condition, argIndex *int) (string, []in
 	case OpIsNotNull:
 		return fmt.Sprintf("%s IS NOT NULL", cond.Field), args
 
+	case OpExpr:
+		exprArgs, _ := cond.Value.([]interface{})
+		return "(" + rewritePlaceholders(cond.Field, b.dialect, argIndex) + ")", exprArgs
+
+	case OpILike, OpNotILike, OpRegexp, OpNotRegexp:
+		return buildTextCondition(b.dialect, cond, argIndex)
+
+	case OpJSON:
+		return buildJSONCondition(b.dialect, cond, argIndex)
+
+	case OpMatch:
+		return cond.Value.(TextSearch).buildMatch(b.dialect, b.tableName, argIndex)
+
+	case OpExists, OpNotExists:
+		sub := cond.Value.(Subquery)
+		subSQL, subArgs := sub.buildSubquery(argIndex)
+		return fmt.Sprintf("%s (%s)", cond.Operator, subSQL), subArgs
+
 	case OpIn, OpNotIn:
+		if sub, ok := cond.Value.(Subquery); ok {
+			subSQL, subArgs := sub.buildSubquery(argIndex)
+			return fmt.Sprintf("%s %s (%s)", cond.Field, cond.Operator, subSQL), subArgs
+		}
+
 		values := interfaceSlice(cond.Value)
 		placeholders := make([]string, len(values))
 		for i, v := range values {
@@ -336,6 +589,11 @@ func (b *Builder[T]) buildCondition(cond Condition, argIndex *int) (string, []in
 		return sql, args
 
 	default:
+		if sub, ok := cond.Value.(Subquery); ok {
+			subSQL, subArgs := sub.buildSubquery(argIndex)
+			return fmt.Sprintf("%s %s (%s)", cond.Field, cond.Operator, subSQL), subArgs
+		}
+
 		sql := fmt.Sprintf("%s %s %s", cond.Field, cond.Operator, b.dialect.Placeholder(*argIndex))
 		args = append(args, cond.Value)
 		*argIndex++
@@ -345,21 +603,21 @@ func (b *Builder[T]) buildCondition(cond Condition, argIndex *int) (string, []in
 
 // buildConditionGroup constrói um grupo de condições.
 func (b *Builder[T]) buildConditionGroup(group ConditionGroup, argIndex *int) (string, []interface{}) {
+	// Grupo vazio: AND de nada é verdadeiro, OR de nada é falso
+	if len(group.Conditions) == 0 {
+		if group.Operator == LogicalOr {
+			return "1 = 0", nil
+		}
+		return "1 = 1", nil
+	}
+
 	var parts []string
 	var args []interface{}
 
 	for _, cond := range group.Conditions {
-		switch c := cond.(type) {
-		case Condition:
-			sql, condArgs := b.buildCondition(c, argIndex)
-			parts = append(parts, sql)
-			args = append(args, condArgs...)
-
-		case ConditionGroup:
-			sql, condArgs := b.buildConditionGroup(c, argIndex)
-			parts = append(parts, "("+sql+")")
-			args = append(args, condArgs...)
-		}
+		sql, condArgs := b.buildExpression(cond, argIndex)
+		parts = append(parts, sql)
+		args = append(args, condArgs...)
 	}
 
 	operator := " AND "
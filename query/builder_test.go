@@ -0,0 +1,89 @@
+package query
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/core"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqlUser é o modelo usado nos testes de geração de SQL.
+type sqlUser struct {
+	core.Model
+	Name string `db:"name"`
+	Age  int    `db:"age"`
+}
+
+var sqlUserFields = struct {
+	ID    Int64Field
+	Name  StringField
+	Age   IntField
+	Total IntField
+}{
+	ID:    NewInt64Field("id"),
+	Name:  NewStringField("name"),
+	Age:   NewIntField("age"),
+	Total: NewIntField("total"),
+}
+
+// sqlCase é um builder com o SQL e os argumentos esperados de ToSQL.
+type sqlCase struct {
+	name     string
+	builder  *Builder[sqlUser]
+	wantSQL  string
+	wantArgs []interface{}
+}
+
+// runSQLCases compara o resultado de ToSQL de cada caso.
+func runSQLCases(t *testing.T, cases []sqlCase) {
+	t.Helper()
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := tt.builder.ToSQL()
+			if err != nil {
+				t.Fatalf("ToSQL failed: %v", err)
+			}
+			if sql != tt.wantSQL {
+				t.Errorf("Unexpected SQL:\n got: %s\nwant: %s", sql, tt.wantSQL)
+			}
+			if len(args) == 0 && len(tt.wantArgs) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("Unexpected args: got %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+// queryLogger guarda as queries executadas.
+type queryLogger struct {
+	queries []string
+}
+
+func (l *queryLogger) LogQuery(query string, args []interface{}, duration int64) {
+	l.queries = append(l.queries, query)
+}
+
+func (l *queryLogger) LogError(query string, args []interface{}, err error) {
+	l.queries = append(l.queries, query)
+}
+
+// openSQLite cria um banco SQLite em memória com a tabela users.
+func openSQLite(t *testing.T) *sql.DB {
+	t.Helper()
+
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if _, err := sqlDB.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT, age INTEGER, created_at DATETIME, updated_at DATETIME)`); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	return sqlDB
+}
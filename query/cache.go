@@ -0,0 +1,111 @@
+package query
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/GabrielOnRails/genus/core"
+)
+
+// Cache guarda os resultados de Find, First e Count no cache do DB por ttl
+// (ver core.DB.SetCache). A chave é a tabela mais o SQL gerado e seus argumentos,
+// então queries diferentes (ou de tenants diferentes) têm entradas diferentes.
+// Create, Update e Delete na tabela invalidam suas entradas.
+//
+// Queries dentro de transações e com bloqueio de linhas (ForUpdate) nunca usam o cache.
+// A entrada é invalidada por escritas em qualquer tabela lida pela query: a tabela
+// principal, JOINs, subqueries, CTEs e operações de conjunto. Tabelas citadas apenas
+// em SQL cru (Expr) não são rastreadas; use db.InvalidateTable nesses casos.
+// Os resultados são copiados ao entrar e ao sair do cache, então alterá-los
+// não altera o cache.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+//
+// Exemplo:
+//
+//	countries, err := genus.Table[Country](db).OrderBy(CountryFields.Name.Asc()).Cache(time.Hour).Find(ctx)
+func (b *Builder[T]) Cache(ttl time.Duration) *Builder[T] {
+	newBuilder := b.clone()
+	newBuilder.cacheTTL = ttl
+	return newBuilder
+}
+
+// UseCache define o cache usado por Cache. genus.Table já usa o cache do DB;
+// use com builders criados por NewBuilder.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) UseCache(cache core.Cache) *Builder[T] {
+	newBuilder := b.clone()
+	newBuilder.cache = cache
+	return newBuilder
+}
+
+// cacheKey retorna a chave de cache da query, ou false se ela não deve usar o cache.
+func (s *builderState) cacheKey(kind, query string, args []interface{}) (string, bool) {
+	if s.cache == nil || s.cacheTTL <= 0 || s.lockMode != LockNone {
+		return "", false
+	}
+	if _, inTx := s.executor.(*sql.Tx); inTx {
+		return "", false
+	}
+	return fmt.Sprintf("%s|%s|%s|%#v", s.tableName, kind, query, args), true
+}
+
+// cacheTables retorna as tabelas lidas pela query, sem repetições.
+func (b *Builder[T]) cacheTables() []string {
+	var tables []string
+	seen := make(map[string]bool)
+	for _, table := range b.referencedTables() {
+		if !seen[table] {
+			seen[table] = true
+			tables = append(tables, table)
+		}
+	}
+	return tables
+}
+
+// referencedTables retorna as tabelas lidas pelo builder e por suas subqueries.
+// CTEs não são tabelas: suas escritas acontecem nas tabelas das queries da CTE.
+func (b *Builder[T]) referencedTables() []string {
+	b = b.scoped()
+
+	var tables []string
+	if b.fromSubquery != nil {
+		tables = append(tables, b.fromSubquery.referencedTables()...)
+	} else if !b.isCTE(b.tableName) {
+		tables = append(tables, b.tableName)
+	}
+	for _, j := range b.joins {
+		if !b.isCTE(j.table) {
+			tables = append(tables, j.table)
+		}
+	}
+	for _, c := range b.ctes {
+		tables = append(tables, c.anchor.referencedTables()...)
+		if c.recursive != nil {
+			tables = append(tables, c.recursive.referencedTables()...)
+		}
+	}
+	for _, expr := range b.conditions {
+		tables = append(tables, expressionTables(expr)...)
+	}
+	return tables
+}
+
+// expressionTables retorna as tabelas lidas pelas subqueries de uma expressão.
+func expressionTables(expr Expression) []string {
+	switch e := expr.(type) {
+	case Condition:
+		if sub, ok := e.Value.(Subquery); ok {
+			return sub.referencedTables()
+		}
+	case ConditionGroup:
+		var tables []string
+		for _, c := range e.Conditions {
+			tables = append(tables, expressionTables(c)...)
+		}
+		return tables
+	case NotExpression:
+		return expressionTables(e.Expression)
+	}
+	return nil
+}
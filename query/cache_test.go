@@ -0,0 +1,118 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GabrielOnRails/genus/cache"
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+)
+
+// cacheRow é gravado pelo core.DB na tabela table, invalidando o cache.
+type cacheRow struct {
+	core.Model
+	Name  string `db:"name"`
+	Age   int    `db:"age"`
+	table string
+}
+
+func (r cacheRow) TableName() string {
+	return r.table
+}
+
+func TestCacheTables(t *testing.T) {
+	users := NewBuilder[sqlUser](nil, postgres.New(), nil, "users")
+	orders := NewBuilder[sqlUser](nil, postgres.New(), nil, "orders")
+	items := NewBuilder[sqlUser](nil, postgres.New(), nil, "items")
+	f := sqlUserFields
+
+	tests := []struct {
+		name    string
+		builder *Builder[sqlUser]
+		want    []string
+	}{
+		{"table", users, []string{"users"}},
+		{"join", users.Join("orders", "orders.user_id = users.id"), []string{"users", "orders"}},
+		{"subquery condition", users.Where(Or(f.Age.Gt(18), Not(Exists(orders.Where(f.ID.InSubquery(items.Select("id"))))))), []string{"users", "orders", "items"}},
+		{"cte", users.With("big", orders.Where(f.Total.Gt(100))).From("big"), []string{"orders"}},
+		{"from subquery", users.FromSubquery(orders, "recent"), []string{"orders"}},
+		{"set operation", users.Union(orders).Except(users), []string{"users", "orders"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.builder.cacheTables(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected tables %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCachedUnion(t *testing.T) {
+	sqlDB := openSQLite(t)
+	if _, err := sqlDB.Exec(`CREATE TABLE orders (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT, age INTEGER, created_at DATETIME, updated_at DATETIME)`); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+
+	logger := &queryLogger{}
+	db := core.NewWithLogger(sqlDB, sqlite.New(), logger)
+	db.SetCache(cache.NewLRU(100))
+	ctx := context.Background()
+
+	insert := func(table, name string) {
+		t.Helper()
+		if err := db.Create(ctx, &cacheRow{Name: name, table: table}); err != nil {
+			t.Fatalf("Failed to insert into %s: %v", table, err)
+		}
+	}
+	insert("users", "a")
+	insert("orders", "b")
+
+	users := NewBuilder[sqlUser](sqlDB, sqlite.New(), logger, "users").UseCache(db.Cache())
+	orders := NewBuilder[sqlUser](sqlDB, sqlite.New(), logger, "orders")
+	union := users.Cache(time.Minute).Union(orders).OrderBy(sqlUserFields.Name.Asc())
+
+	// find executa a UNION e retorna quantas vezes ela chegou ao banco
+	selects := 0
+	find := func(want int) {
+		t.Helper()
+		logger.queries = nil
+		result, err := union.Find(ctx)
+		if err != nil {
+			t.Fatalf("Find failed: %v", err)
+		}
+		if len(result) != want {
+			t.Errorf("Expected %d rows, got %d", want, len(result))
+		}
+		for _, query := range logger.queries {
+			if strings.HasPrefix(query, "SELECT") {
+				selects++
+			}
+		}
+	}
+
+	find(2)
+	find(2)
+	if selects != 1 {
+		t.Fatalf("Expected cached union to execute once, got %d", selects)
+	}
+
+	// Uma escrita em qualquer uma das tabelas invalida a entrada
+	insert("orders", "c")
+	find(3)
+	if selects != 2 {
+		t.Errorf("Expected union to run again after a write to orders, got %d executions", selects)
+	}
+
+	insert("users", "d")
+	find(4)
+	find(4)
+	if selects != 3 {
+		t.Errorf("Expected union to run once after a write to users, got %d executions", selects)
+	}
+}
@@ -12,13 +12,32 @@ const (
 	OpLte       Operator = "<="
 	OpLike      Operator = "LIKE"
 	OpNotLike   Operator = "NOT LIKE"
+	OpILike     Operator = "ILIKE"
+	OpNotILike  Operator = "NOT ILIKE"
+	OpRegexp    Operator = "REGEXP"
+	OpNotRegexp Operator = "NOT REGEXP"
 	OpIn        Operator = "IN"
 	OpNotIn     Operator = "NOT IN"
 	OpBetween   Operator = "BETWEEN"
 	OpIsNull    Operator = "IS NULL"
 	OpIsNotNull Operator = "IS NOT NULL"
+	OpExists    Operator = "EXISTS"
+	OpNotExists Operator = "NOT EXISTS"
+	// OpExpr indica uma expressão SQL literal criada com Expr.
+	OpExpr Operator = "EXPR"
+	// OpJSON indica um predicado sobre uma coluna JSON (ver JSONField).
+	OpJSON Operator = "JSON"
+	// OpMatch indica uma busca full-text (ver Search).
+	OpMatch Operator = "MATCH"
 )
 
+// Expression é uma expressão booleana que pode ser usada em Where, And, Or e Not.
+// É implementada apenas por Condition, ConditionGroup e NotExpression; o método
+// não exportado impede que outros tipos sejam passados por engano.
+type Expression interface {
+	isExpression()
+}
+
 // Condition representa uma condição WHERE.
 type Condition struct {
 	Field    string
@@ -26,6 +45,8 @@ type Condition struct {
 	Value    interface{}
 }
 
+func (Condition) isExpression() {}
+
 // LogicalOperator representa operadores lógicos (AND, OR).
 type LogicalOperator string
 
@@ -34,51 +55,49 @@ const (
 	LogicalOr  LogicalOperator = "OR"
 )
 
-// ConditionGroup agrupa múltiplas condições com um operador lógico.
+// ConditionGroup agrupa múltiplas expressões com um operador lógico.
 type ConditionGroup struct {
-	Conditions []interface{} // pode ser Condition ou ConditionGroup
+	Conditions []Expression // Condition, ConditionGroup ou NotExpression
 	Operator   LogicalOperator
 }
 
-// And combina condições com AND.
-func And(conditions ...Condition) ConditionGroup {
-	conds := make([]interface{}, len(conditions))
-	for i, c := range conditions {
-		conds[i] = c
-	}
+func (ConditionGroup) isExpression() {}
+
+// NotExpression nega uma expressão: NOT (expressão).
+type NotExpression struct {
+	Expression Expression
+}
+
+func (NotExpression) isExpression() {}
+
+// And combina expressões com AND. Aceita condições e grupos aninhados.
+//
+// Exemplo:
+//
+//	query.And(
+//	    UserFields.IsActive.Eq(true),
+//	    query.Or(UserFields.Age.Lt(18), UserFields.Age.Gt(65)),
+//	)
+func And(expressions ...Expression) ConditionGroup {
 	return ConditionGroup{
-		Conditions: conds,
+		Conditions: expressions,
 		Operator:   LogicalAnd,
 	}
 }
 
-// Or combina condições com OR.
-func Or(conditions ...Condition) ConditionGroup {
-	conds := make([]interface{}, len(conditions))
-	for i, c := range conditions {
-		conds[i] = c
-	}
+// Or combina expressões com OR. Aceita condições e grupos aninhados.
+func Or(expressions ...Expression) ConditionGroup {
 	return ConditionGroup{
-		Conditions: conds,
+		Conditions: expressions,
 		Operator:   LogicalOr,
 	}
 }
 
-// Not cria uma condição NOT (usando != para simplificar).
-func Not(condition Condition) Condition {
-	switch condition.Operator {
-	case OpEq:
-		condition.Operator = OpNe
-	case OpNe:
-		condition.Operator = OpEq
-	case OpIsNull:
-		condition.Operator = OpIsNotNull
-	case OpIsNotNull:
-		condition.Operator = OpIsNull
-	case OpIn:
-		condition.Operator = OpNotIn
-	case OpNotIn:
-		condition.Operator = OpIn
+// Not nega qualquer expressão, gerando NOT (expressão).
+// Not(Not(e)) retorna e.
+func Not(expression Expression) Expression {
+	if not, ok := expression.(NotExpression); ok {
+		return not.Expression
 	}
-	return condition
+	return NotExpression{Expression: expression}
 }
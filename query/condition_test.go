@@ -0,0 +1,33 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+)
+
+func TestNotAndNestedGroups(t *testing.T) {
+	users := NewBuilder[sqlUser](nil, postgres.New(), nil, "users")
+	f := sqlUserFields
+
+	runSQLCases(t, []sqlCase{
+		{
+			name:     "not",
+			builder:  users.Where(Not(f.Age.Gt(18))),
+			wantSQL:  `SELECT * FROM "users" WHERE NOT (age > $1)`,
+			wantArgs: []interface{}{18},
+		},
+		{
+			name:     "double not",
+			builder:  users.Where(Not(Not(f.Age.Gt(18)))),
+			wantSQL:  `SELECT * FROM "users" WHERE age > $1`,
+			wantArgs: []interface{}{18},
+		},
+		{
+			name:     "nested groups",
+			builder:  users.Where(Or(And(f.Age.Gt(18), f.Name.Eq("a")), Not(Or(f.Age.Lt(5), f.Name.IsNull())))),
+			wantSQL:  `SELECT * FROM "users" WHERE ((age > $1 AND name = $2) OR NOT (age < $3 OR name IS NULL))`,
+			wantArgs: []interface{}{18, "a", 5},
+		},
+	})
+}
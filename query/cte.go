@@ -0,0 +1,103 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cte representa uma common table expression: name AS (query).
+// Em CTEs recursivas, recursive é unido ao anchor com UNION ALL.
+type cte struct {
+	name      string
+	anchor    Subquery
+	recursive Subquery
+}
+
+// join representa um INNER JOIN table ON on.
+type join struct {
+	table string
+	on    string
+}
+
+// With adiciona uma common table expression: WITH name AS (sub).
+// Use From(name) para consultar a CTE.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+//
+// Exemplo:
+//
+//	active := genus.Table[User](db).Where(UserFields.IsActive.Eq(true))
+//	users, err := genus.Table[User](db).With("active_users", active).From("active_users").Find(ctx)
+func (b *Builder[T]) With(name string, sub Subquery) *Builder[T] {
+	newBuilder := b.clone()
+	newBuilder.ctes = append(newBuilder.ctes, cte{name: name, anchor: sub})
+	return newBuilder
+}
+
+// WithRecursive adiciona uma CTE recursiva: WITH RECURSIVE name AS (anchor UNION ALL recursive).
+// O builder recursive referencia a própria CTE, normalmente via Join.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+//
+// Exemplo (organograma a partir de um gestor):
+//
+//	anchor := genus.Table[Employee](db).Where(EmployeeFields.ID.Eq(rootID))
+//	recursive := genus.Table[Employee](db).
+//	    Select(`"employees".*`).
+//	    Join("org_chart", `"org_chart".id = "employees".manager_id`)
+//
+//	employees, err := genus.Table[Employee](db).
+//	    WithRecursive("org_chart", anchor, recursive).
+//	    From("org_chart").
+//	    Find(ctx)
+func (b *Builder[T]) WithRecursive(name string, anchor, recursive Subquery) *Builder[T] {
+	newBuilder := b.clone()
+	newBuilder.ctes = append(newBuilder.ctes, cte{name: name, anchor: anchor, recursive: recursive})
+	return newBuilder
+}
+
+// From define a tabela (ou CTE) consultada, substituindo a tabela do modelo.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) From(table string) *Builder[T] {
+	newBuilder := b.clone()
+	newBuilder.tableName = table
+	newBuilder.fromSubquery = nil
+	newBuilder.fromAlias = ""
+	return newBuilder
+}
+
+// Join adiciona um INNER JOIN table ON on.
+// on é SQL literal: nunca construa-o a partir de entrada do usuário.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) Join(table, on string) *Builder[T] {
+	newBuilder := b.clone()
+	newBuilder.joins = append(newBuilder.joins, join{table: table, on: on})
+	return newBuilder
+}
+
+// buildWithClause constrói a cláusula WITH [RECURSIVE] com todas as CTEs.
+func (b *builderState) buildWithClause(argIndex *int) (string, []interface{}) {
+	var parts []string
+	var args []interface{}
+	recursive := false
+
+	for _, c := range b.ctes {
+		anchorSQL, anchorArgs := c.anchor.buildSubquery(argIndex)
+		args = append(args, anchorArgs...)
+		body := anchorSQL
+
+		if c.recursive != nil {
+			recursive = true
+			recursiveSQL, recursiveArgs := c.recursive.buildSubquery(argIndex)
+			args = append(args, recursiveArgs...)
+			body = anchorSQL + " UNION ALL " + recursiveSQL
+		}
+
+		parts = append(parts, fmt.Sprintf("%s AS (%s)", b.dialect.QuoteIdentifier(c.name), body))
+	}
+
+	keyword := "WITH "
+	if recursive {
+		keyword = "WITH RECURSIVE "
+	}
+
+	return keyword + strings.Join(parts, ", "), args
+}
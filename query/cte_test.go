@@ -0,0 +1,22 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+)
+
+func TestCTEPlaceholderNumbering(t *testing.T) {
+	users := NewBuilder[sqlUser](nil, postgres.New(), nil, "users")
+	orders := NewBuilder[sqlUser](nil, postgres.New(), nil, "orders")
+	f := sqlUserFields
+
+	runSQLCases(t, []sqlCase{
+		{
+			name:     "with",
+			builder:  users.With("big", orders.Where(f.Total.Gt(100))).From("big").Where(f.Total.Lt(500)),
+			wantSQL:  `WITH "big" AS (SELECT * FROM "orders" WHERE total > $1) SELECT * FROM "big" WHERE total < $2`,
+			wantArgs: []interface{}{100, 500},
+		},
+	})
+}
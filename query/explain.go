@@ -0,0 +1,153 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PlanFormat é o formato do plano de execução retornado por Explain.
+type PlanFormat string
+
+const (
+	PlanJSON PlanFormat = "json"
+	PlanText PlanFormat = "text"
+)
+
+// Plan é o plano de execução de uma query.
+type Plan struct {
+	// Query é a query analisada (sem o prefixo EXPLAIN)
+	Query string
+	// Args são os argumentos da query
+	Args []interface{}
+	// Format indica se Output é JSON ou texto
+	Format PlanFormat
+	// Output é o plano retornado pelo banco
+	Output string
+}
+
+// ToSQL retorna a query SELECT gerada e seus argumentos, sem executá-la.
+// Útil para testes e debugging. Retorna erro se o builder for inválido
+// (ex: ForUpdate no SQLite). No modo multi-tenant, como não há contexto,
+// use ForTenant para definir o tenant; sem ele retorna core.ErrMissingTenant.
+func (b *Builder[T]) ToSQL() (string, []interface{}, error) {
+	b = b.forContext(context.Background())
+	if err := b.buildErr(); err != nil {
+		return "", nil, err
+	}
+	query, args := b.buildSelectQuery()
+	return query, args, nil
+}
+
+// Explain retorna o plano de execução da query na sintaxe do dialeto:
+//   - PostgreSQL: EXPLAIN (FORMAT JSON) / EXPLAIN (ANALYZE, FORMAT JSON)
+//   - MySQL: EXPLAIN FORMAT=JSON / EXPLAIN ANALYZE (texto)
+//   - SQLite: EXPLAIN QUERY PLAN (texto em árvore); analyze não é suportado
+//
+// Com analyze=true a query é realmente executada: cuidado com efeitos colaterais
+// e com o custo em produção.
+func (b *Builder[T]) Explain(ctx context.Context, analyze bool) (Plan, error) {
+	query, args, err := b.forContext(ctx).ToSQL()
+	if err != nil {
+		return Plan{}, err
+	}
+
+	plan := Plan{Query: query, Args: args, Format: PlanJSON}
+
+	var prefix string
+	switch b.dialect.Name() {
+	case "postgres":
+		prefix = "EXPLAIN (FORMAT JSON) "
+		if analyze {
+			prefix = "EXPLAIN (ANALYZE, FORMAT JSON) "
+		}
+	case "mysql":
+		prefix = "EXPLAIN FORMAT=JSON "
+		if analyze {
+			prefix = "EXPLAIN ANALYZE "
+			plan.Format = PlanText
+		}
+	case "sqlite":
+		if analyze {
+			return Plan{}, fmt.Errorf("EXPLAIN ANALYZE is not supported by sqlite")
+		}
+		prefix = "EXPLAIN QUERY PLAN "
+		plan.Format = PlanText
+	default:
+		prefix = "EXPLAIN "
+		plan.Format = PlanText
+	}
+
+	explainQuery := prefix + query
+
+	start := time.Now()
+	rows, err := b.executor.QueryContext(ctx, explainQuery, args...)
+	duration := time.Since(start).Nanoseconds()
+
+	if err != nil {
+		b.logger.LogError(explainQuery, args, err)
+		return Plan{}, fmt.Errorf("failed to explain query: %w", err)
+	}
+	defer rows.Close()
+
+	if b.dialect.Name() == "sqlite" {
+		plan.Output, err = readSQLitePlan(rows)
+	} else {
+		plan.Output, err = readPlanLines(rows)
+	}
+	if err != nil {
+		return Plan{}, err
+	}
+
+	b.logger.LogQuery(explainQuery, args, duration)
+	return plan, nil
+}
+
+// readPlanLines lê um plano de uma coluna por linha, juntando as linhas com "\n".
+func readPlanLines(rows *sql.Rows) (string, error) {
+	var lines []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			return "", fmt.Errorf("failed to scan plan: %w", err)
+		}
+		lines = append(lines, line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// readSQLitePlan lê o resultado de EXPLAIN QUERY PLAN (id, parent, notused, detail)
+// e o formata como uma árvore indentada.
+func readSQLitePlan(rows *sql.Rows) (string, error) {
+	depth := map[int64]int{}
+	var lines []string
+
+	for rows.Next() {
+		var id, parent, notUsed int64
+		var detail string
+		if err := rows.Scan(&id, &parent, &notUsed, &detail); err != nil {
+			return "", fmt.Errorf("failed to scan plan: %w", err)
+		}
+
+		level := 0
+		if parentLevel, ok := depth[parent]; ok {
+			level = parentLevel + 1
+		}
+		depth[id] = level
+
+		lines = append(lines, strings.Repeat("  ", level)+detail)
+	}
+
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,65 @@
+package query
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+)
+
+func TestToSQL(t *testing.T) {
+	f := sqlUserFields
+
+	// ToSQL não executa nada: o executor pode ser nil
+	sql, args, err := NewBuilder[sqlUser](nil, postgres.New(), nil, "users").
+		Where(f.Age.Gt(18)).
+		OrderBy(f.Name.Asc()).
+		Limit(10).
+		Offset(20).
+		ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL failed: %v", err)
+	}
+	if want := `SELECT * FROM "users" WHERE age > $1 ORDER BY name ASC LIMIT 10 OFFSET 20`; sql != want {
+		t.Errorf("Unexpected SQL:\n got: %s\nwant: %s", sql, want)
+	}
+	if len(args) != 1 || args[0] != 18 {
+		t.Errorf("Unexpected args: %#v", args)
+	}
+
+	// Builders inválidos retornam erro em vez de SQL
+	if _, _, err := NewBuilder[sqlUser](nil, sqlite.New(), nil, "users").ForUpdate().ToSQL(); err == nil {
+		t.Error("Expected ForUpdate on sqlite to fail")
+	}
+}
+
+func TestExplainSQLite(t *testing.T) {
+	logger := &queryLogger{}
+	users := NewBuilder[sqlUser](openSQLite(t), sqlite.New(), logger, "users").
+		Where(sqlUserFields.Age.Gt(18))
+
+	plan, err := users.Explain(context.Background(), false)
+	if err != nil {
+		t.Fatalf("Explain failed: %v", err)
+	}
+
+	query, _, _ := users.ToSQL()
+	if plan.Query != query {
+		t.Errorf("Expected plan query %q, got %q", query, plan.Query)
+	}
+	if plan.Format != PlanText {
+		t.Errorf("Expected text plan, got %s", plan.Format)
+	}
+	if !strings.Contains(plan.Output, "users") {
+		t.Errorf("Expected plan to mention users, got %q", plan.Output)
+	}
+	if len(logger.queries) != 1 || !strings.HasPrefix(logger.queries[0], "EXPLAIN QUERY PLAN ") {
+		t.Errorf("Expected EXPLAIN QUERY PLAN to be logged, got %v", logger.queries)
+	}
+
+	if _, err := users.Explain(context.Background(), true); err == nil {
+		t.Error("Expected EXPLAIN ANALYZE to fail on sqlite")
+	}
+}
@@ -1,14 +1,16 @@
 package query
 
-// Field é a interface base para todos os tipos de campos.
+import "cmp"
+
+// Column é a interface base para todos os tipos de campos.
 // Cada campo conhece seu nome de coluna no banco de dados.
-type Field interface {
+type Column interface {
 	ColumnName() string
 }
 
 // Comparador genérico para criar condições.
 type Comparador[T any] interface {
-	Field
+	Column
 	Eq(value T) Condition
 	Ne(value T) Condition
 	In(values ...T) Condition
@@ -27,95 +29,44 @@ type ComparadorOrdenavel[T any] interface {
 	Between(start, end T) Condition
 }
 
-// StringField representa um campo string com operadores específicos.
-type StringField struct {
+// Field é um campo tipado de qualquer tipo Go, com operadores de igualdade.
+// Funciona com tipos definidos pelo usuário (ex: type Status string) e []byte.
+//
+// Exemplo:
+//
+//	type Status string
+//
+//	var OrderFields = struct {
+//	    Status query.Field[Status]
+//	}{
+//	    Status: query.NewField[Status]("status"),
+//	}
+type Field[T any] struct {
 	column string
 }
 
-func NewStringField(column string) StringField {
-	return StringField{column: column}
+func NewField[T any](column string) Field[T] {
+	return Field[T]{column: column}
 }
 
-func (f StringField) ColumnName() string {
+func (f Field[T]) ColumnName() string {
 	return f.column
 }
 
-func (f StringField) Eq(value string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpEq,
-		Value:    value,
-	}
-}
-
-func (f StringField) Ne(value string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNe,
-		Value:    value,
-	}
-}
-
-func (f StringField) In(values ...string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIn,
-		Value:    values,
-	}
-}
-
-func (f StringField) NotIn(values ...string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNotIn,
-		Value:    values,
-	}
-}
-
-func (f StringField) IsNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNull,
-	}
-}
-
-func (f StringField) IsNotNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNotNull,
-	}
-}
-
-func (f StringField) Like(pattern string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpLike,
-		Value:    pattern,
-	}
-}
-
-func (f StringField) NotLike(pattern string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNotLike,
-		Value:    pattern,
-	}
-}
-
-// IntField representa um campo int com operadores numéricos.
-type IntField struct {
-	column string
+func (f Field[T]) Asc() OrderBy {
+	return OrderBy{Column: f.column}
 }
 
-func NewIntField(column string) IntField {
-	return IntField{column: column}
+func (f Field[T]) Desc() OrderBy {
+	return OrderBy{Column: f.column, Desc: true}
 }
 
-func (f IntField) ColumnName() string {
-	return f.column
+// Col retorna a coluna como operando, para comparações com outras colunas.
+func (f Field[T]) Col() Operand[T] {
+	return columnOperand[T](f.column)
 }
 
-func (f IntField) Eq(value int) Condition {
+func (f Field[T]) Eq(value T) Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpEq,
@@ -123,7 +74,7 @@ func (f IntField) Eq(value int) Condition {
 	}
 }
 
-func (f IntField) Ne(value int) Condition {
+func (f Field[T]) Ne(value T) Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpNe,
@@ -131,106 +82,78 @@ func (f IntField) Ne(value int) Condition {
 	}
 }
 
-func (f IntField) Gt(value int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpGt,
-		Value:    value,
-	}
-}
-
-func (f IntField) Gte(value int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpGte,
-		Value:    value,
-	}
-}
-
-func (f IntField) Lt(value int) Condition {
+func (f Field[T]) In(values ...T) Condition {
 	return Condition{
 		Field:    f.column,
-		Operator: OpLt,
-		Value:    value,
+		Operator: OpIn,
+		Value:    anySlice(values),
 	}
 }
 
-func (f IntField) Lte(value int) Condition {
+func (f Field[T]) NotIn(values ...T) Condition {
 	return Condition{
 		Field:    f.column,
-		Operator: OpLte,
-		Value:    value,
+		Operator: OpNotIn,
+		Value:    anySlice(values),
 	}
 }
 
-func (f IntField) In(values ...int) Condition {
+func (f Field[T]) InSubquery(sub Subquery) Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpIn,
-		Value:    values,
+		Value:    sub,
 	}
 }
 
-func (f IntField) NotIn(values ...int) Condition {
+func (f Field[T]) NotInSubquery(sub Subquery) Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpNotIn,
-		Value:    values,
-	}
-}
-
-func (f IntField) Between(start, end int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpBetween,
-		Value:    []int{start, end},
+		Value:    sub,
 	}
 }
 
-func (f IntField) IsNull() Condition {
+func (f Field[T]) IsNull() Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpIsNull,
 	}
 }
 
-func (f IntField) IsNotNull() Condition {
+func (f Field[T]) IsNotNull() Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpIsNotNull,
 	}
 }
 
-// Int64Field representa um campo int64.
-type Int64Field struct {
-	column string
-}
-
-func NewInt64Field(column string) Int64Field {
-	return Int64Field{column: column}
+// OrderedField é um campo de tipo ordenável (números, strings e tipos
+// derivados como type UserID int64), com operadores de comparação.
+//
+// Exemplo:
+//
+//	type UserID int64
+//
+//	var UserFields = struct {
+//	    ID query.OrderedField[UserID]
+//	}{
+//	    ID: query.NewOrderedField[UserID]("id"),
+//	}
+type OrderedField[T cmp.Ordered] struct {
+	Field[T]
 }
 
-func (f Int64Field) ColumnName() string {
-	return f.column
-}
-
-func (f Int64Field) Eq(value int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpEq,
-		Value:    value,
-	}
+func NewOrderedField[T cmp.Ordered](column string) OrderedField[T] {
+	return OrderedField[T]{NewField[T](column)}
 }
 
-func (f Int64Field) Ne(value int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNe,
-		Value:    value,
-	}
+// Col retorna a coluna como operando, para comparações com outras colunas e aritmética.
+func (f OrderedField[T]) Col() OrderedOperand[T] {
+	return orderedColumnOperand[T](f.column)
 }
 
-func (f Int64Field) Gt(value int64) Condition {
+func (f OrderedField[T]) Gt(value T) Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpGt,
@@ -238,7 +161,7 @@ func (f Int64Field) Gt(value int64) Condition {
 	}
 }
 
-func (f Int64Field) Gte(value int64) Condition {
+func (f OrderedField[T]) Gte(value T) Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpGte,
@@ -246,7 +169,7 @@ func (f Int64Field) Gte(value int64) Condition {
 	}
 }
 
-func (f Int64Field) Lt(value int64) Condition {
+func (f OrderedField[T]) Lt(value T) Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpLt,
@@ -254,7 +177,7 @@ func (f Int64Field) Lt(value int64) Condition {
 	}
 }
 
-func (f Int64Field) Lte(value int64) Condition {
+func (f OrderedField[T]) Lte(value T) Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpLte,
@@ -262,632 +185,133 @@ func (f Int64Field) Lte(value int64) Condition {
 	}
 }
 
-func (f Int64Field) In(values ...int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIn,
-		Value:    values,
-	}
-}
-
-func (f Int64Field) NotIn(values ...int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNotIn,
-		Value:    values,
-	}
-}
-
-func (f Int64Field) Between(start, end int64) Condition {
+func (f OrderedField[T]) Between(start, end T) Condition {
 	return Condition{
 		Field:    f.column,
 		Operator: OpBetween,
-		Value:    []int64{start, end},
-	}
-}
-
-func (f Int64Field) IsNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNull,
+		Value:    []interface{}{start, end},
 	}
 }
 
-func (f Int64Field) IsNotNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNotNull,
-	}
-}
+// --- Campos Opcionais para suporte a NULL ---
 
-// BoolField representa um campo booleano.
-type BoolField struct {
-	column string
+// OptionalField é a variante de Field para colunas que podem ser NULL
+// (core.Optional[T] no modelo). Os operadores recebem T; use IsNull/IsNotNull para NULL.
+type OptionalField[T any] struct {
+	Field[T]
 }
 
-func NewBoolField(column string) BoolField {
-	return BoolField{column: column}
+func NewOptionalField[T any](column string) OptionalField[T] {
+	return OptionalField[T]{NewField[T](column)}
 }
 
-func (f BoolField) ColumnName() string {
-	return f.column
+// OptionalOrderedField é a variante de OrderedField para colunas que podem ser NULL.
+type OptionalOrderedField[T cmp.Ordered] struct {
+	OrderedField[T]
 }
 
-func (f BoolField) Eq(value bool) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpEq,
-		Value:    value,
-	}
+func NewOptionalOrderedField[T cmp.Ordered](column string) OptionalOrderedField[T] {
+	return OptionalOrderedField[T]{NewOrderedField[T](column)}
 }
 
-func (f BoolField) Ne(value bool) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNe,
-		Value:    value,
-	}
-}
+// --- Tipos nomeados para os tipos mais comuns ---
 
-func (f BoolField) IsNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNull,
-	}
+// StringField representa um campo string com operadores específicos.
+type StringField struct {
+	Field[string]
 }
 
-func (f BoolField) IsNotNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNotNull,
-	}
+func NewStringField(column string) StringField {
+	return StringField{NewField[string](column)}
 }
 
-func (f BoolField) In(values ...bool) Condition {
+func (f StringField) Like(pattern string) Condition {
 	return Condition{
 		Field:    f.column,
-		Operator: OpIn,
-		Value:    values,
+		Operator: OpLike,
+		Value:    pattern,
 	}
 }
 
-func (f BoolField) NotIn(values ...bool) Condition {
+func (f StringField) NotLike(pattern string) Condition {
 	return Condition{
 		Field:    f.column,
-		Operator: OpNotIn,
-		Value:    values,
+		Operator: OpNotLike,
+		Value:    pattern,
 	}
 }
 
-// Float64Field representa um campo float64.
-type Float64Field struct {
-	column string
-}
+// IntField representa um campo int com operadores numéricos.
+type IntField = OrderedField[int]
 
-func NewFloat64Field(column string) Float64Field {
-	return Float64Field{column: column}
+func NewIntField(column string) IntField {
+	return NewOrderedField[int](column)
 }
 
-func (f Float64Field) ColumnName() string {
-	return f.column
-}
+// Int64Field representa um campo int64 com operadores numéricos.
+type Int64Field = OrderedField[int64]
 
-func (f Float64Field) Eq(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpEq,
-		Value:    value,
-	}
+func NewInt64Field(column string) Int64Field {
+	return NewOrderedField[int64](column)
 }
 
-func (f Float64Field) Ne(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNe,
-		Value:    value,
-	}
-}
+// BoolField representa um campo booleano.
+type BoolField = Field[bool]
 
-func (f Float64Field) Gt(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpGt,
-		Value:    value,
-	}
+func NewBoolField(column string) BoolField {
+	return NewField[bool](column)
 }
 
-func (f Float64Field) Gte(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpGte,
-		Value:    value,
-	}
-}
+// Float64Field representa um campo float64 com operadores numéricos.
+type Float64Field = OrderedField[float64]
 
-func (f Float64Field) Lt(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpLt,
-		Value:    value,
-	}
+func NewFloat64Field(column string) Float64Field {
+	return NewOrderedField[float64](column)
 }
 
-func (f Float64Field) Lte(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpLte,
-		Value:    value,
-	}
+// OptionalStringField representa um campo string que pode ser NULL.
+// Usa core.Optional[string] para tipagem segura.
+type OptionalStringField struct {
+	StringField
 }
 
-func (f Float64Field) In(values ...float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIn,
-		Value:    values,
-	}
+func NewOptionalStringField(column string) OptionalStringField {
+	return OptionalStringField{NewStringField(column)}
 }
 
-func (f Float64Field) NotIn(values ...float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNotIn,
-		Value:    values,
-	}
-}
+// OptionalIntField representa um campo int que pode ser NULL.
+type OptionalIntField = OptionalOrderedField[int]
 
-func (f Float64Field) Between(start, end float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpBetween,
-		Value:    []float64{start, end},
-	}
+func NewOptionalIntField(column string) OptionalIntField {
+	return NewOptionalOrderedField[int](column)
 }
 
-func (f Float64Field) IsNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNull,
-	}
-}
+// OptionalInt64Field representa um campo int64 que pode ser NULL.
+type OptionalInt64Field = OptionalOrderedField[int64]
 
-func (f Float64Field) IsNotNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNotNull,
-	}
+func NewOptionalInt64Field(column string) OptionalInt64Field {
+	return NewOptionalOrderedField[int64](column)
 }
 
-// --- Campos Opcionais para suporte a NULL ---
-
-// OptionalStringField representa um campo string que pode ser NULL.
-// Usa core.Optional[string] para tipagem segura.
-type OptionalStringField struct {
-	column string
-}
+// OptionalBoolField representa um campo bool que pode ser NULL.
+type OptionalBoolField = OptionalField[bool]
 
-func NewOptionalStringField(column string) OptionalStringField {
-	return OptionalStringField{column: column}
+func NewOptionalBoolField(column string) OptionalBoolField {
+	return NewOptionalField[bool](column)
 }
 
-func (f OptionalStringField) ColumnName() string {
-	return f.column
-}
+// OptionalFloat64Field representa um campo float64 que pode ser NULL.
+type OptionalFloat64Field = OptionalOrderedField[float64]
 
-func (f OptionalStringField) Eq(value string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpEq,
-		Value:    value,
-	}
+func NewOptionalFloat64Field(column string) OptionalFloat64Field {
+	return NewOptionalOrderedField[float64](column)
 }
 
-func (f OptionalStringField) Ne(value string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNe,
-		Value:    value,
-	}
-}
-
-func (f OptionalStringField) In(values ...string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIn,
-		Value:    values,
-	}
-}
-
-func (f OptionalStringField) NotIn(values ...string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNotIn,
-		Value:    values,
-	}
-}
-
-func (f OptionalStringField) IsNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNull,
-	}
-}
-
-func (f OptionalStringField) IsNotNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNotNull,
-	}
-}
-
-func (f OptionalStringField) Like(pattern string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpLike,
-		Value:    pattern,
-	}
-}
-
-func (f OptionalStringField) NotLike(pattern string) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNotLike,
-		Value:    pattern,
-	}
-}
-
-// OptionalIntField representa um campo int que pode ser NULL.
-type OptionalIntField struct {
-	column string
-}
-
-func NewOptionalIntField(column string) OptionalIntField {
-	return OptionalIntField{column: column}
-}
-
-func (f OptionalIntField) ColumnName() string {
-	return f.column
-}
-
-func (f OptionalIntField) Eq(value int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpEq,
-		Value:    value,
-	}
-}
-
-func (f OptionalIntField) Ne(value int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNe,
-		Value:    value,
-	}
-}
-
-func (f OptionalIntField) Gt(value int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpGt,
-		Value:    value,
-	}
-}
-
-func (f OptionalIntField) Gte(value int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpGte,
-		Value:    value,
-	}
-}
-
-func (f OptionalIntField) Lt(value int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpLt,
-		Value:    value,
-	}
-}
-
-func (f OptionalIntField) Lte(value int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpLte,
-		Value:    value,
-	}
-}
-
-func (f OptionalIntField) In(values ...int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIn,
-		Value:    values,
-	}
-}
-
-func (f OptionalIntField) NotIn(values ...int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNotIn,
-		Value:    values,
-	}
-}
-
-func (f OptionalIntField) Between(start, end int) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpBetween,
-		Value:    []int{start, end},
-	}
-}
-
-func (f OptionalIntField) IsNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNull,
-	}
-}
-
-func (f OptionalIntField) IsNotNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNotNull,
-	}
-}
-
-// OptionalInt64Field representa um campo int64 que pode ser NULL.
-type OptionalInt64Field struct {
-	column string
-}
-
-func NewOptionalInt64Field(column string) OptionalInt64Field {
-	return OptionalInt64Field{column: column}
-}
-
-func (f OptionalInt64Field) ColumnName() string {
-	return f.column
-}
-
-func (f OptionalInt64Field) Eq(value int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpEq,
-		Value:    value,
-	}
-}
-
-func (f OptionalInt64Field) Ne(value int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNe,
-		Value:    value,
-	}
-}
-
-func (f OptionalInt64Field) Gt(value int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpGt,
-		Value:    value,
-	}
-}
-
-func (f OptionalInt64Field) Gte(value int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpGte,
-		Value:    value,
-	}
-}
-
-func (f OptionalInt64Field) Lt(value int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpLt,
-		Value:    value,
-	}
-}
-
-func (f OptionalInt64Field) Lte(value int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpLte,
-		Value:    value,
-	}
-}
-
-func (f OptionalInt64Field) In(values ...int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIn,
-		Value:    values,
-	}
-}
-
-func (f OptionalInt64Field) NotIn(values ...int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNotIn,
-		Value:    values,
-	}
-}
-
-func (f OptionalInt64Field) Between(start, end int64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpBetween,
-		Value:    []int64{start, end},
-	}
-}
-
-func (f OptionalInt64Field) IsNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNull,
-	}
-}
-
-func (f OptionalInt64Field) IsNotNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNotNull,
-	}
-}
-
-// OptionalBoolField representa um campo booleano que pode ser NULL.
-type OptionalBoolField struct {
-	column string
-}
-
-func NewOptionalBoolField(column string) OptionalBoolField {
-	return OptionalBoolField{column: column}
-}
-
-func (f OptionalBoolField) ColumnName() string {
-	return f.column
-}
-
-func (f OptionalBoolField) Eq(value bool) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpEq,
-		Value:    value,
-	}
-}
-
-func (f OptionalBoolField) Ne(value bool) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNe,
-		Value:    value,
-	}
-}
-
-func (f OptionalBoolField) IsNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNull,
-	}
-}
-
-func (f OptionalBoolField) IsNotNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNotNull,
-	}
-}
-
-func (f OptionalBoolField) In(values ...bool) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIn,
-		Value:    values,
-	}
-}
-
-func (f OptionalBoolField) NotIn(values ...bool) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNotIn,
-		Value:    values,
-	}
-}
-
-// OptionalFloat64Field representa um campo float64 que pode ser NULL.
-type OptionalFloat64Field struct {
-	column string
-}
-
-func NewOptionalFloat64Field(column string) OptionalFloat64Field {
-	return OptionalFloat64Field{column: column}
-}
-
-func (f OptionalFloat64Field) ColumnName() string {
-	return f.column
-}
-
-func (f OptionalFloat64Field) Eq(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpEq,
-		Value:    value,
-	}
-}
-
-func (f OptionalFloat64Field) Ne(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNe,
-		Value:    value,
-	}
-}
-
-func (f OptionalFloat64Field) Gt(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpGt,
-		Value:    value,
-	}
-}
-
-func (f OptionalFloat64Field) Gte(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpGte,
-		Value:    value,
-	}
-}
-
-func (f OptionalFloat64Field) Lt(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpLt,
-		Value:    value,
-	}
-}
-
-func (f OptionalFloat64Field) Lte(value float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpLte,
-		Value:    value,
-	}
-}
-
-func (f OptionalFloat64Field) In(values ...float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIn,
-		Value:    values,
-	}
-}
-
-func (f OptionalFloat64Field) NotIn(values ...float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpNotIn,
-		Value:    values,
-	}
-}
-
-func (f OptionalFloat64Field) Between(start, end float64) Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpBetween,
-		Value:    []float64{start, end},
-	}
-}
-
-func (f OptionalFloat64Field) IsNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNull,
-	}
-}
-
-func (f OptionalFloat64Field) IsNotNull() Condition {
-	return Condition{
-		Field:    f.column,
-		Operator: OpIsNotNull,
+// anySlice converte []T para []interface{} para uso em IN.
+func anySlice[T any](values []T) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = v
 	}
+	return result
 }
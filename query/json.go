@@ -0,0 +1,449 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GabrielOnRails/genus/core"
+)
+
+// JSONField representa uma coluna JSON (core.JSON[T]), com extração de caminhos,
+// contenção e existência de chaves. O SQL é gerado para o dialeto do builder:
+// operadores JSONB no PostgreSQL, JSON_EXTRACT no MySQL e json_extract no SQLite.
+//
+// Exemplo:
+//
+//	var UserFields = struct {
+//	    Settings query.JSONField
+//	}{
+//	    Settings: query.NewJSONField("settings"),
+//	}
+//
+//	genus.Table[User](db).Where(query.And(
+//	    UserFields.Settings.Path("theme").Text().Eq("dark"),
+//	    UserFields.Settings.Path("limits", "projects").Int().Gte(10),
+//	    UserFields.Settings.HasKey("beta"),
+//	))
+type JSONField struct {
+	column string
+}
+
+func NewJSONField(column string) JSONField {
+	return JSONField{column: column}
+}
+
+func (f JSONField) ColumnName() string {
+	return f.column
+}
+
+func (f JSONField) IsNull() Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (f JSONField) IsNotNull() Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpIsNotNull,
+	}
+}
+
+// Path retorna um caminho dentro do documento. Cada chave é um nível de objeto;
+// chaves numéricas (ex: "0") acessam posições de arrays.
+func (f JSONField) Path(keys ...string) JSONPath {
+	return JSONPath{column: f.column, keys: keys}
+}
+
+// Contains cria a condição "o documento contém value" (@> no PostgreSQL).
+// Ver JSONPath.Contains.
+func (f JSONField) Contains(value interface{}) Condition {
+	return f.Path().Contains(value)
+}
+
+// HasKey cria a condição "o documento tem a chave key no primeiro nível".
+func (f JSONField) HasKey(key string) Condition {
+	return f.Path(key).Exists()
+}
+
+// JSONPath é um caminho dentro de uma coluna JSON.
+type JSONPath struct {
+	column string
+	keys   []string
+}
+
+// Text extrai o valor do caminho como texto, para comparação com strings.
+func (p JSONPath) Text() JSONValue[string] {
+	return JSONAs[string](p)
+}
+
+// Int extrai o valor do caminho como número inteiro.
+func (p JSONPath) Int() JSONValue[int64] {
+	return JSONAs[int64](p)
+}
+
+// Float extrai o valor do caminho como número.
+func (p JSONPath) Float() JSONValue[float64] {
+	return JSONAs[float64](p)
+}
+
+// Bool extrai o valor do caminho como booleano.
+func (p JSONPath) Bool() JSONValue[bool] {
+	return JSONAs[bool](p)
+}
+
+// Exists cria a condição "o caminho existe no documento" (mesmo que seu valor seja null).
+func (p JSONPath) Exists() Condition {
+	return p.condition(jsonPredicate{mode: jsonExists, keys: p.keys})
+}
+
+// Contains cria a condição "o valor no caminho contém value".
+// Objetos contêm um objeto se tiverem todas as suas chaves com valores contidos;
+// arrays contêm um array se tiverem todos os seus elementos.
+//
+// Usa @> no PostgreSQL e JSON_CONTAINS no MySQL. No SQLite, que não tem operador
+// de contenção, a condição é emulada com json_extract e json_each.
+//
+// Se value não puder ser serializado como JSON, a query retorna o erro ao ser executada.
+func (p JSONPath) Contains(value interface{}) Condition {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return p.condition(jsonPredicate{
+			mode: jsonContains,
+			keys: p.keys,
+			err:  fmt.Errorf("failed to marshal JSON Contains value: %w", err),
+		})
+	}
+	return p.condition(jsonPredicate{mode: jsonContains, keys: p.keys, value: string(data)})
+}
+
+func (p JSONPath) condition(pred jsonPredicate) Condition {
+	return Condition{
+		Field:    p.column,
+		Operator: OpJSON,
+		Value:    pred,
+	}
+}
+
+// JSONValue é o valor extraído de um caminho JSON, comparável com valores do tipo V.
+type JSONValue[V any] struct {
+	path JSONPath
+	kind jsonKind
+}
+
+// JSONAs extrai o valor do caminho como V. Strings são comparadas como texto,
+// números como números e bool como booleano, inclusive para tipos derivados
+// (ex: type Theme string).
+//
+// Exemplo:
+//
+//	type Plan string
+//	query.JSONAs[Plan](UserFields.Settings.Path("plan")).Eq(PlanPro)
+func JSONAs[V any](p JSONPath) JSONValue[V] {
+	return JSONValue[V]{path: p, kind: jsonKindOf(reflect.TypeOf((*V)(nil)).Elem())}
+}
+
+func (v JSONValue[V]) Eq(value V) Condition {
+	return v.compare(OpEq, value)
+}
+
+func (v JSONValue[V]) Ne(value V) Condition {
+	return v.compare(OpNe, value)
+}
+
+func (v JSONValue[V]) Gt(value V) Condition {
+	return v.compare(OpGt, value)
+}
+
+func (v JSONValue[V]) Gte(value V) Condition {
+	return v.compare(OpGte, value)
+}
+
+func (v JSONValue[V]) Lt(value V) Condition {
+	return v.compare(OpLt, value)
+}
+
+func (v JSONValue[V]) Lte(value V) Condition {
+	return v.compare(OpLte, value)
+}
+
+func (v JSONValue[V]) compare(op Operator, value V) Condition {
+	return v.path.condition(jsonPredicate{
+		mode:  jsonCompare,
+		keys:  v.path.keys,
+		kind:  v.kind,
+		op:    op,
+		value: value,
+	})
+}
+
+// jsonKind define como o valor extraído é convertido antes da comparação.
+type jsonKind int
+
+const (
+	jsonText jsonKind = iota
+	jsonNumber
+	jsonBool
+)
+
+func jsonKindOf(t reflect.Type) jsonKind {
+	switch t.Kind() {
+	case reflect.Bool:
+		return jsonBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return jsonNumber
+	default:
+		return jsonText
+	}
+}
+
+// jsonMode é o tipo de predicado JSON.
+type jsonMode int
+
+const (
+	jsonCompare jsonMode = iota
+	jsonContains
+	jsonExists
+)
+
+// jsonPredicate é o valor de uma Condition com OpJSON. O SQL só é gerado no
+// build, quando o dialeto é conhecido. err é o erro de construção do predicado,
+// retornado pela execução da query (ver buildErr).
+type jsonPredicate struct {
+	mode  jsonMode
+	keys  []string
+	kind  jsonKind
+	op    Operator
+	value interface{}
+	err   error
+}
+
+// buildJSONCondition constrói um predicado JSON na sintaxe do dialeto.
+func buildJSONCondition(dialect core.Dialect, cond Condition, argIndex *int) (string, []interface{}) {
+	pred := cond.Value.(jsonPredicate)
+	column := cond.Field
+
+	var sql string
+	var args []interface{}
+
+	switch dialect.Name() {
+	case "postgres":
+		sql, args = buildPostgresJSON(column, pred)
+	case "mysql":
+		sql, args = buildMySQLJSON(column, pred)
+	default:
+		sql, args = buildSQLiteJSON(column, pred)
+	}
+
+	return rewritePlaceholders(sql, dialect, argIndex), args
+}
+
+// buildPostgresJSON usa os operadores JSONB (#>, #>>, @>, ?).
+func buildPostgresJSON(column string, pred jsonPredicate) (string, []interface{}) {
+	path := postgresJSONPath(pred.keys)
+
+	switch pred.mode {
+	case jsonExists:
+		// "?" pode usar um índice GIN; caminhos aninhados usam #>, que retorna
+		// NULL apenas para caminhos inexistentes
+		if len(pred.keys) == 1 && !isArrayIndex(pred.keys[0]) {
+			return fmt.Sprintf("%s ?? ?", column), []interface{}{pred.keys[0]}
+		}
+		return fmt.Sprintf("(%s #> ?) IS NOT NULL", column), []interface{}{path}
+
+	case jsonContains:
+		if len(pred.keys) == 0 {
+			return fmt.Sprintf("%s @> ?::jsonb", column), []interface{}{pred.value}
+		}
+		return fmt.Sprintf("(%s #> ?) @> ?::jsonb", column), []interface{}{path, pred.value}
+
+	default:
+		extracted := fmt.Sprintf("(%s #>> ?)", column)
+		switch pred.kind {
+		case jsonNumber:
+			extracted += "::numeric"
+		case jsonBool:
+			extracted += "::boolean"
+		}
+		return fmt.Sprintf("%s %s ?", extracted, pred.op), []interface{}{path, pred.value}
+	}
+}
+
+// buildMySQLJSON usa JSON_EXTRACT, JSON_CONTAINS e JSON_CONTAINS_PATH.
+func buildMySQLJSON(column string, pred jsonPredicate) (string, []interface{}) {
+	path := jsonPathExpr(pred.keys)
+
+	switch pred.mode {
+	case jsonExists:
+		return fmt.Sprintf("JSON_CONTAINS_PATH(%s, 'one', ?)", column), []interface{}{path}
+
+	case jsonContains:
+		return fmt.Sprintf("JSON_CONTAINS(%s, ?, ?)", column), []interface{}{pred.value, path}
+
+	default:
+		switch pred.kind {
+		case jsonText:
+			return fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(%s, ?)) %s ?", column, pred.op), []interface{}{path, pred.value}
+		case jsonBool:
+			// true/false em JSON só são iguais a outros valores JSON
+			return fmt.Sprintf("JSON_EXTRACT(%s, ?) %s CAST(? AS JSON)", column, pred.op),
+				[]interface{}{path, strconv.FormatBool(reflect.ValueOf(pred.value).Bool())}
+		default:
+			return fmt.Sprintf("JSON_EXTRACT(%s, ?) %s ?", column, pred.op), []interface{}{path, pred.value}
+		}
+	}
+}
+
+// buildSQLiteJSON usa json_extract, json_type e json_each (extensão JSON1).
+func buildSQLiteJSON(column string, pred jsonPredicate) (string, []interface{}) {
+	path := jsonPathExpr(pred.keys)
+
+	switch pred.mode {
+	case jsonExists:
+		// json_type retorna 'null' para um valor null e NULL para um caminho inexistente
+		return fmt.Sprintf("json_type(%s, ?) IS NOT NULL", column), []interface{}{path}
+
+	case jsonContains:
+		var value interface{}
+		decoder := json.NewDecoder(strings.NewReader(pred.value.(string)))
+		decoder.UseNumber()
+		_ = decoder.Decode(&value)
+
+		parts, args := sqliteJSONContains(column, pred.keys, value)
+		switch len(parts) {
+		case 0:
+			return "1 = 1", nil
+		case 1:
+			return parts[0], args
+		default:
+			return "(" + strings.Join(parts, " AND ") + ")", args
+		}
+
+	default:
+		return fmt.Sprintf("json_extract(%s, ?) %s ?", column, pred.op), []interface{}{path, pred.value}
+	}
+}
+
+// sqliteJSONContains emula @> com uma condição por valor escalar do documento esperado.
+func sqliteJSONContains(column string, keys []string, value interface{}) ([]string, []interface{}) {
+	path := jsonPathExpr(keys)
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var parts []string
+		var args []interface{}
+		for _, name := range names {
+			childKeys := append(append([]string{}, keys...), name)
+			childParts, childArgs := sqliteJSONContains(column, childKeys, v[name])
+			parts = append(parts, childParts...)
+			args = append(args, childArgs...)
+		}
+		return parts, args
+
+	case []interface{}:
+		var parts []string
+		var args []interface{}
+		for _, elem := range v {
+			if isJSONScalar(elem) {
+				parts = append(parts, fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s, ?) WHERE value = ?)", column))
+				args = append(args, path, sqliteJSONScalar(elem))
+				continue
+			}
+			// Objetos e arrays aninhados são comparados pelo texto JSON normalizado
+			data, _ := json.Marshal(elem)
+			parts = append(parts, fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s, ?) WHERE value = json(?))", column))
+			args = append(args, path, string(data))
+		}
+		return parts, args
+
+	case nil:
+		return []string{fmt.Sprintf("json_type(%s, ?) = 'null'", column)}, []interface{}{path}
+
+	default:
+		return []string{fmt.Sprintf("json_extract(%s, ?) = ?", column)}, []interface{}{path, sqliteJSONScalar(v)}
+	}
+}
+
+func isJSONScalar(value interface{}) bool {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}, nil:
+		return false
+	default:
+		return true
+	}
+}
+
+// sqliteJSONScalar converte um escalar decodificado para o valor retornado pelo SQLite.
+func sqliteJSONScalar(value interface{}) interface{} {
+	number, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+	if i, err := number.Int64(); err == nil {
+		return i
+	}
+	f, _ := number.Float64()
+	return f
+}
+
+// simpleJSONKey reconhece chaves que não precisam de aspas em um caminho JSON.
+var simpleJSONKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// jsonPathExpr converte as chaves para um caminho do MySQL/SQLite (ex: $.a."b c"[0]).
+func jsonPathExpr(keys []string) string {
+	var sb strings.Builder
+	sb.WriteString("$")
+
+	for _, key := range keys {
+		switch {
+		case isArrayIndex(key):
+			sb.WriteString("[" + key + "]")
+		case simpleJSONKey.MatchString(key):
+			sb.WriteString("." + key)
+		default:
+			sb.WriteString(`."` + escapeJSONKey(key) + `"`)
+		}
+	}
+
+	return sb.String()
+}
+
+// postgresJSONPath converte as chaves para um literal text[] (ex: {"a","b c","0"}).
+func postgresJSONPath(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = `"` + escapeJSONKey(key) + `"`
+	}
+	return "{" + strings.Join(quoted, ",") + "}"
+}
+
+func escapeJSONKey(key string) string {
+	key = strings.ReplaceAll(key, `\`, `\\`)
+	return strings.ReplaceAll(key, `"`, `\"`)
+}
+
+func isArrayIndex(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+package query
+
+import "fmt"
+
+// LockMode representa o modo de bloqueio de linhas de um SELECT.
+type LockMode string
+
+const (
+	LockNone      LockMode = ""
+	LockForUpdate LockMode = "FOR UPDATE"
+	LockForShare  LockMode = "FOR SHARE"
+)
+
+// LockWait define o comportamento quando uma linha já está bloqueada.
+type LockWait string
+
+const (
+	LockWaitDefault LockWait = ""
+	LockSkipLocked  LockWait = "SKIP LOCKED"
+	LockNoWait      LockWait = "NOWAIT"
+)
+
+// ForUpdate bloqueia as linhas selecionadas para escrita (SELECT ... FOR UPDATE).
+// Deve ser usado dentro de uma transação (db.WithTx). Não suportado no SQLite.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+//
+// Exemplo (fila de jobs):
+//
+//	err := g.WithTx(ctx, func(tx *genus.Genus) error {
+//	    jobs, err := genus.Table[Job](tx).
+//	        Where(JobFields.Status.Eq("pending")).
+//	        OrderBy(JobFields.ID.Asc()).
+//	        Limit(10).
+//	        ForUpdate().
+//	        SkipLocked().
+//	        Find(ctx)
+//	    // ...
+//	})
+func (b *Builder[T]) ForUpdate() *Builder[T] {
+	return b.withLock(LockForUpdate, b.lockWait)
+}
+
+// ForShare bloqueia as linhas selecionadas para leitura (SELECT ... FOR SHARE).
+// Não suportado no SQLite.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) ForShare() *Builder[T] {
+	return b.withLock(LockForShare, b.lockWait)
+}
+
+// SkipLocked ignora as linhas já bloqueadas por outras transações.
+// Se nenhum modo de bloqueio foi definido, usa FOR UPDATE.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) SkipLocked() *Builder[T] {
+	return b.withLock(b.lockMode, LockSkipLocked)
+}
+
+// NoWait falha imediatamente se alguma linha já estiver bloqueada.
+// Se nenhum modo de bloqueio foi definido, usa FOR UPDATE.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) NoWait() *Builder[T] {
+	return b.withLock(b.lockMode, LockNoWait)
+}
+
+// withLock define o bloqueio, registrando erro se o dialeto não o suportar.
+func (b *Builder[T]) withLock(mode LockMode, wait LockWait) *Builder[T] {
+	newBuilder := b.clone()
+	if mode == LockNone {
+		mode = LockForUpdate
+	}
+	newBuilder.lockMode = mode
+	newBuilder.lockWait = wait
+
+	if b.dialect.Name() == "sqlite" && newBuilder.err == nil {
+		newBuilder.err = fmt.Errorf("row locking (%s) is not supported by sqlite", mode)
+	}
+
+	return newBuilder
+}
+
+// buildLockClause constrói a cláusula de bloqueio (com espaço inicial), ou "".
+func (s *builderState) buildLockClause() string {
+	if s.lockMode == LockNone {
+		return ""
+	}
+	if s.lockWait == LockWaitDefault {
+		return " " + string(s.lockMode)
+	}
+	return fmt.Sprintf(" %s %s", s.lockMode, s.lockWait)
+}
@@ -0,0 +1,133 @@
+package query
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Operand é um lado tipado de uma comparação: uma coluna, um valor ou uma
+// expressão sobre colunas. Permite comparar colunas entre si sem placeholders,
+// mantendo a checagem de tipos em tempo de compilação.
+//
+// Obtenha a partir de um campo com Col(), ou de um valor com Val (OrderedVal
+// para comparações de ordem e aritmética).
+//
+// Exemplo:
+//
+//	// WHERE (is_verified = is_active)
+//	genus.Table[User](db).Where(UserFields.IsVerified.Col().Eq(UserFields.IsActive.Col()))
+type Operand[T any] struct {
+	sql  string
+	args []interface{}
+}
+
+// OrderedOperand é um Operand de um tipo ordenável (números), com
+// operadores de comparação e aritmética.
+//
+// Exemplo:
+//
+//	// WHERE (stock < (reserved + $1))
+//	genus.Table[Product](db).Where(
+//	    ProductFields.Stock.Col().Lt(ProductFields.Reserved.Col().Add(query.OrderedVal(5))),
+//	)
+type OrderedOperand[T any] struct {
+	Operand[T]
+}
+
+// Val cria um operando a partir de um valor, que é enviado como parâmetro.
+//
+// Exemplo:
+//
+//	// WHERE (is_active = $1)
+//	genus.Table[User](db).Where(UserFields.IsActive.Col().Eq(query.Val(true)))
+func Val[T any](value T) Operand[T] {
+	return Operand[T]{sql: "?", args: []interface{}{value}}
+}
+
+// OrderedVal cria um operando ordenável a partir de um valor, para uso com
+// Gt, Lt e os operadores aritméticos de OrderedOperand.
+func OrderedVal[T cmp.Ordered](value T) OrderedOperand[T] {
+	return OrderedOperand[T]{Val(value)}
+}
+
+// columnOperand cria um operando que referencia uma coluna.
+func columnOperand[T any](column string) Operand[T] {
+	return Operand[T]{sql: column}
+}
+
+// orderedColumnOperand cria um operando ordenável que referencia uma coluna.
+func orderedColumnOperand[T any](column string) OrderedOperand[T] {
+	return OrderedOperand[T]{columnOperand[T](column)}
+}
+
+// compare cria a condição "left op right".
+func (o Operand[T]) compare(op Operator, other Operand[T]) Condition {
+	args := append(append([]interface{}{}, o.args...), other.args...)
+	return Expr(fmt.Sprintf("%s %s %s", o.sql, op, other.sql), args...)
+}
+
+// Eq cria a condição "operando = other".
+func (o Operand[T]) Eq(other Operand[T]) Condition {
+	return o.compare(OpEq, other)
+}
+
+// Ne cria a condição "operando != other".
+func (o Operand[T]) Ne(other Operand[T]) Condition {
+	return o.compare(OpNe, other)
+}
+
+// Eq cria a condição "operando = other".
+func (o OrderedOperand[T]) Eq(other OrderedOperand[T]) Condition {
+	return o.compare(OpEq, other.Operand)
+}
+
+// Ne cria a condição "operando != other".
+func (o OrderedOperand[T]) Ne(other OrderedOperand[T]) Condition {
+	return o.compare(OpNe, other.Operand)
+}
+
+// Gt cria a condição "operando > other".
+func (o OrderedOperand[T]) Gt(other OrderedOperand[T]) Condition {
+	return o.compare(OpGt, other.Operand)
+}
+
+// Gte cria a condição "operando >= other".
+func (o OrderedOperand[T]) Gte(other OrderedOperand[T]) Condition {
+	return o.compare(OpGte, other.Operand)
+}
+
+// Lt cria a condição "operando < other".
+func (o OrderedOperand[T]) Lt(other OrderedOperand[T]) Condition {
+	return o.compare(OpLt, other.Operand)
+}
+
+// Lte cria a condição "operando <= other".
+func (o OrderedOperand[T]) Lte(other OrderedOperand[T]) Condition {
+	return o.compare(OpLte, other.Operand)
+}
+
+// arithmetic cria a expressão "(left op right)".
+func (o OrderedOperand[T]) arithmetic(op string, other OrderedOperand[T]) OrderedOperand[T] {
+	args := append(append([]interface{}{}, o.args...), other.args...)
+	return OrderedOperand[T]{Operand[T]{sql: fmt.Sprintf("(%s %s %s)", o.sql, op, other.sql), args: args}}
+}
+
+// Add cria a expressão "(operando + other)".
+func (o OrderedOperand[T]) Add(other OrderedOperand[T]) OrderedOperand[T] {
+	return o.arithmetic("+", other)
+}
+
+// Sub cria a expressão "(operando - other)".
+func (o OrderedOperand[T]) Sub(other OrderedOperand[T]) OrderedOperand[T] {
+	return o.arithmetic("-", other)
+}
+
+// Mul cria a expressão "(operando * other)".
+func (o OrderedOperand[T]) Mul(other OrderedOperand[T]) OrderedOperand[T] {
+	return o.arithmetic("*", other)
+}
+
+// Div cria a expressão "(operando / other)".
+func (o OrderedOperand[T]) Div(other OrderedOperand[T]) OrderedOperand[T] {
+	return o.arithmetic("/", other)
+}
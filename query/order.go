@@ -0,0 +1,120 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NullsOrder define a posição dos valores NULL em uma ordenação.
+type NullsOrder string
+
+const (
+	NullsDefault NullsOrder = ""
+	NullsFirst   NullsOrder = "NULLS FIRST"
+	NullsLast    NullsOrder = "NULLS LAST"
+)
+
+// OrderBy representa uma cláusula ORDER BY.
+// Crie a partir de campos tipados: UserFields.Name.Asc(), UserFields.Age.Desc().
+type OrderBy struct {
+	Column string
+	Desc   bool
+	Nulls  NullsOrder
+
+	// search ordena pela relevância de uma busca full-text (ver TextSearch.Rank)
+	search *TextSearch
+}
+
+// NullsFirst coloca os valores NULL antes dos demais.
+func (o OrderBy) NullsFirst() OrderBy {
+	o.Nulls = NullsFirst
+	return o
+}
+
+// NullsLast coloca os valores NULL depois dos demais.
+func (o OrderBy) NullsLast() OrderBy {
+	o.Nulls = NullsLast
+	return o
+}
+
+// OrderByParam converte uma ordenação vinda do usuário (ex: query string) em OrderBy,
+// aceitando apenas as chaves presentes em allowed.
+// O formato é uma lista separada por vírgulas; o prefixo "-" indica ordem decrescente.
+//
+// Exemplo:
+//
+//	allowed := map[string]query.Column{
+//	    "name":  UserFields.Name,
+//	    "age":   UserFields.Age,
+//	}
+//	orders, err := query.OrderByParam(allowed, r.URL.Query().Get("sort")) // "-age,name"
+//	if err != nil {
+//	    return err // 400 Bad Request
+//	}
+//	users, err := genus.Table[User](db).OrderBy(orders...).Find(ctx)
+func OrderByParam(allowed map[string]Column, input string) ([]OrderBy, error) {
+	var orders []OrderBy
+
+	for _, part := range strings.Split(input, ",") {
+		key := strings.TrimSpace(part)
+		if key == "" {
+			continue
+		}
+
+		desc := false
+		if strings.HasPrefix(key, "-") {
+			desc = true
+			key = key[1:]
+		} else if strings.HasPrefix(key, "+") {
+			key = key[1:]
+		}
+
+		field, ok := allowed[key]
+		if !ok {
+			return nil, fmt.Errorf("invalid sort field: %q", key)
+		}
+
+		orders = append(orders, OrderBy{Column: field.ColumnName(), Desc: desc})
+	}
+
+	return orders, nil
+}
+
+// buildOrderByClause constrói a lista de ordenações (sem o "ORDER BY").
+func (b *Builder[T]) buildOrderByClause(argIndex *int) (string, []interface{}) {
+	var args []interface{}
+	orderParts := make([]string, 0, len(b.orderBy))
+	for _, order := range b.orderBy {
+		if order.search != nil {
+			rankSQL, rankArgs := order.search.buildRank(b.dialect, b.tableName, argIndex)
+			orderParts = append(orderParts, rankSQL)
+			args = append(args, rankArgs...)
+			continue
+		}
+
+		direction := "ASC"
+		if order.Desc {
+			direction = "DESC"
+		}
+
+		if order.Nulls == NullsDefault {
+			orderParts = append(orderParts, order.Column+" "+direction)
+			continue
+		}
+
+		// MySQL não suporta NULLS FIRST/LAST; emula ordenando por "coluna IS NULL" antes
+		if b.dialect.Name() == "mysql" {
+			nullsDirection := "ASC"
+			if order.Nulls == NullsFirst {
+				nullsDirection = "DESC"
+			}
+			orderParts = append(orderParts,
+				fmt.Sprintf("%s IS NULL %s", order.Column, nullsDirection),
+				order.Column+" "+direction)
+			continue
+		}
+
+		orderParts = append(orderParts, fmt.Sprintf("%s %s %s", order.Column, direction, order.Nulls))
+	}
+	return strings.Join(orderParts, ", "), args
+}
@@ -0,0 +1,32 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/GabrielOnRails/genus/dialects/mysql"
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+)
+
+func TestNullsOrdering(t *testing.T) {
+	f := sqlUserFields
+	orders := []OrderBy{f.Age.Asc().NullsLast(), f.Name.Desc().NullsFirst(), f.ID.Asc()}
+
+	runSQLCases(t, []sqlCase{
+		{
+			name:    "postgres",
+			builder: NewBuilder[sqlUser](nil, postgres.New(), nil, "users").OrderBy(orders...),
+			wantSQL: `SELECT * FROM "users" ORDER BY age ASC NULLS LAST, name DESC NULLS FIRST, id ASC`,
+		},
+		{
+			name:    "mysql emulation",
+			builder: NewBuilder[sqlUser](nil, mysql.New(), nil, "users").OrderBy(orders...),
+			wantSQL: "SELECT * FROM `users` ORDER BY age IS NULL ASC, age ASC, name IS NULL DESC, name DESC, id ASC",
+		},
+		{
+			name:    "sqlite",
+			builder: NewBuilder[sqlUser](nil, sqlite.New(), nil, "users").OrderBy(orders...),
+			wantSQL: `SELECT * FROM "users" ORDER BY age ASC NULLS LAST, name DESC NULLS FIRST, id ASC`,
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GabrielOnRails/genus/core"
+)
+
+// RawQuery é uma query SQL escrita à mão que ainda retorna []T.
+// Passa pelo mesmo logger, reescrita de placeholders e scanning do Builder.
+type RawQuery[T any] struct {
+	executor core.Executor
+	dialect  core.Dialect
+	logger   core.Logger
+	sql      string
+	args     []interface{}
+}
+
+// NewRawQuery cria uma query SQL crua para o tipo T.
+// Use "?" como placeholder em qualquer dialeto; ele é reescrito para $1, $2, ...
+// no PostgreSQL. Para um "?" literal (ex: operador JSONB), use "??".
+func NewRawQuery[T any](executor core.Executor, dialect core.Dialect, logger core.Logger, sql string, args ...interface{}) *RawQuery[T] {
+	return &RawQuery[T]{
+		executor: executor,
+		dialect:  dialect,
+		logger:   logger,
+		sql:      sql,
+		args:     args,
+	}
+}
+
+// Find executa a query e retorna um slice de T.
+func (r *RawQuery[T]) Find(ctx context.Context) ([]T, error) {
+	argIndex := 1
+	query := rewritePlaceholders(r.sql, r.dialect, &argIndex)
+	return findAll[T](ctx, r.executor, r.logger, query, r.args)
+}
+
+// First retorna o primeiro resultado ou erro se não encontrado.
+// A query não é alterada: inclua LIMIT 1 se necessário.
+func (r *RawQuery[T]) First(ctx context.Context) (T, error) {
+	results, err := r.Find(ctx)
+
+	var zero T
+	if err != nil {
+		return zero, err
+	}
+
+	if len(results) == 0 {
+		return zero, fmt.Errorf("no rows found")
+	}
+
+	return results[0], nil
+}
+
+// Expr cria uma condição a partir de SQL literal, com "?" como placeholder.
+// A expressão é envolvida em parênteses e pode ser combinada com And/Or.
+// sql nunca deve ser construído a partir de entrada do usuário; passe valores em args.
+//
+// Exemplo:
+//
+//	genus.Table[User](db).Where(query.Expr("lower(email) = ?", strings.ToLower(email)))
+func Expr(sql string, args ...interface{}) Condition {
+	return Condition{
+		Field:    sql,
+		Operator: OpExpr,
+		Value:    args,
+	}
+}
+
+// rewritePlaceholders substitui cada "?" pelo placeholder do dialeto, continuando a
+// numeração de argIndex. Ignora "?" dentro de strings ('...') e identificadores ("...").
+// "??" é convertido em um "?" literal.
+func rewritePlaceholders(sql string, dialect core.Dialect, argIndex *int) string {
+	var sb strings.Builder
+	var quote rune
+
+	runes := []rune(sql)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+			sb.WriteRune(r)
+
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+			sb.WriteRune(r)
+
+		case r == '?' && i+1 < len(runes) && runes[i+1] == '?':
+			sb.WriteRune('?')
+			i++
+
+		case r == '?':
+			sb.WriteString(dialect.Placeholder(*argIndex))
+			*argIndex++
+
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/mysql"
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+)
+
+func TestRewritePlaceholders(t *testing.T) {
+	tests := []struct {
+		name      string
+		dialect   core.Dialect
+		sql       string
+		start     int
+		want      string
+		wantIndex int
+	}{
+		{"continues numbering", postgres.New(), "a = ? AND b = ?", 3, "a = $3 AND b = $4", 5},
+		{"ignores string literals", postgres.New(), "name = '?' AND x = ?", 1, "name = '?' AND x = $1", 2},
+		{"ignores escaped quotes", postgres.New(), "name = 'it''s ?' AND x = ?", 1, "name = 'it''s ?' AND x = $1", 2},
+		{"ignores quoted identifiers", postgres.New(), `"weird?col" = ?`, 1, `"weird?col" = $1`, 2},
+		{"double question mark is literal", postgres.New(), "data ?? 'key' AND id = ?", 1, "data ? 'key' AND id = $1", 2},
+		{"mysql keeps question marks", mysql.New(), "a = ? AND `b?` = ?", 1, "a = ? AND `b?` = ?", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := tt.start
+			got := rewritePlaceholders(tt.sql, tt.dialect, &index)
+			if got != tt.want {
+				t.Errorf("Unexpected SQL:\n got: %s\nwant: %s", got, tt.want)
+			}
+			if index != tt.wantIndex {
+				t.Errorf("Expected next index %d, got %d", tt.wantIndex, index)
+			}
+		})
+	}
+}
+
+func TestExprPlaceholderNumbering(t *testing.T) {
+	users := NewBuilder[sqlUser](nil, postgres.New(), nil, "users")
+	f := sqlUserFields
+
+	runSQLCases(t, []sqlCase{
+		{
+			name:     "expr between fields",
+			builder:  users.Where(f.Age.Gt(18)).Where(Expr("status = ? AND data ?? 'key'", "paid")).Where(f.Name.Eq("a")),
+			wantSQL:  `SELECT * FROM "users" WHERE age > $1 AND (status = $2 AND data ? 'key') AND name = $3`,
+			wantArgs: []interface{}{18, "paid", "a"},
+		},
+	})
+}
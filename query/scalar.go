@@ -0,0 +1,156 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Aggregate representa uma expressão agregada (SUM, MAX, ...) cujo resultado é do tipo V.
+// Crie com Sum, Avg, Min, Max ou CountOf e execute com Scalar.
+type Aggregate[V any] struct {
+	expr string
+}
+
+// SQL retorna a expressão SQL do agregado.
+func (a Aggregate[V]) SQL() string {
+	return a.expr
+}
+
+// Sum cria um agregado SUM(coluna).
+func Sum[V any](field Comparador[V]) Aggregate[V] {
+	return Aggregate[V]{expr: fmt.Sprintf("SUM(%s)", field.ColumnName())}
+}
+
+// Min cria um agregado MIN(coluna).
+func Min[V any](field Comparador[V]) Aggregate[V] {
+	return Aggregate[V]{expr: fmt.Sprintf("MIN(%s)", field.ColumnName())}
+}
+
+// Max cria um agregado MAX(coluna).
+func Max[V any](field Comparador[V]) Aggregate[V] {
+	return Aggregate[V]{expr: fmt.Sprintf("MAX(%s)", field.ColumnName())}
+}
+
+// Avg cria um agregado AVG(coluna). O resultado é sempre float64.
+func Avg(field Column) Aggregate[float64] {
+	return Aggregate[float64]{expr: fmt.Sprintf("AVG(%s)", field.ColumnName())}
+}
+
+// CountOf cria um agregado COUNT(coluna), que ignora valores NULL.
+func CountOf(field Column) Aggregate[int64] {
+	return Aggregate[int64]{expr: fmt.Sprintf("COUNT(%s)", field.ColumnName())}
+}
+
+// CountDistinct cria um agregado COUNT(DISTINCT coluna).
+func CountDistinct(field Column) Aggregate[int64] {
+	return Aggregate[int64]{expr: fmt.Sprintf("COUNT(DISTINCT %s)", field.ColumnName())}
+}
+
+// Pluck retorna os valores de uma única coluna, respeitando condições, ordenação e paginação.
+// Valores NULL são retornados como o valor zero de V.
+//
+// Exemplo:
+//
+//	emails, err := query.Pluck[string](ctx, genus.Table[User](db), UserFields.Email) // []string
+func Pluck[V any, T any](ctx context.Context, b *Builder[T], field Comparador[V]) ([]V, error) {
+	b = b.forContext(ctx)
+	if err := b.buildErr(); err != nil {
+		return nil, err
+	}
+
+	query, args := b.Select(field.ColumnName()).buildSelectQuery()
+
+	start := time.Now()
+	rows, err := b.executor.QueryContext(ctx, query, args...)
+	duration := time.Since(start).Nanoseconds()
+
+	if err != nil {
+		b.logger.LogError(query, args, err)
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var results []V
+	for rows.Next() {
+		// sql.Null converte com as regras de database/sql, então V pode ser
+		// uint ou um tipo nomeado (type UserID int64); NULL vira o valor zero
+		var value sql.Null[V]
+		if err := rows.Scan(&value); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		results = append(results, value.V)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	b.logger.LogQuery(query, args, duration)
+	return results, nil
+}
+
+// Scalar executa um agregado sobre as linhas que satisfazem as condições do builder.
+// Se o agregado resultar em NULL (ex: SUM de zero linhas), retorna o valor zero de V.
+//
+// Exemplo:
+//
+//	oldest, err := query.Scalar(ctx, genus.Table[User](db), query.Max[int](UserFields.Age))
+//	avgAge, err := query.Scalar(ctx, genus.Table[User](db), query.Avg(UserFields.Age))
+func Scalar[V any, T any](ctx context.Context, b *Builder[T], agg Aggregate[V]) (V, error) {
+	b = b.forContext(ctx)
+	if err := b.buildErr(); err != nil {
+		var zero V
+		return zero, err
+	}
+
+	scalarBuilder := b.Select(agg.expr)
+	scalarBuilder.orderBy = nil
+	scalarBuilder.limit = nil
+	scalarBuilder.offset = nil
+	scalarBuilder.lockMode = LockNone
+	query, args := scalarBuilder.buildSelectQuery()
+
+	var value sql.Null[V]
+	start := time.Now()
+	err := b.executor.QueryRowContext(ctx, query, args...).Scan(&value)
+	duration := time.Since(start).Nanoseconds()
+
+	if err != nil {
+		b.logger.LogError(query, args, err)
+		var zero V
+		return zero, fmt.Errorf("failed to execute scalar query: %w", err)
+	}
+
+	b.logger.LogQuery(query, args, duration)
+	return value.V, nil
+}
+
+// Exists retorna true se existe pelo menos um registro que satisfaz as condições.
+// Usa SELECT EXISTS(...), que para na primeira linha encontrada.
+func (b *Builder[T]) Exists(ctx context.Context) (bool, error) {
+	b = b.forContext(ctx)
+	if err := b.buildErr(); err != nil {
+		return false, err
+	}
+
+	innerBuilder := b.Select("1")
+	innerBuilder.orderBy = nil
+	innerBuilder.lockMode = LockNone
+	innerQuery, args := innerBuilder.buildSelectQuery()
+	query := "SELECT EXISTS(" + innerQuery + ")"
+
+	var exists bool
+	start := time.Now()
+	err := b.executor.QueryRowContext(ctx, query, args...).Scan(&exists)
+	duration := time.Since(start).Nanoseconds()
+
+	if err != nil {
+		b.logger.LogError(query, args, err)
+		return false, fmt.Errorf("failed to check existence: %w", err)
+	}
+
+	b.logger.LogQuery(query, args, duration)
+	return exists, nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+
+	"github.com/GabrielOnRails/genus/core"
 )
 
 // fieldPath representa o caminho para um campo através de embedded structs
@@ -94,7 +96,7 @@ func buildFieldMapWithPrefix(typ reflect.Type, parentPath fieldPath) map[string]
 		}
 
 		// Obtém o nome da coluna da tag `db`
-		colName := field.Tag.Get("db")
+		colName, _ := core.ParseTag(field.Tag.Get("db"))
 		if colName == "" || colName == "-" {
 			// Se não tem tag ou é "-", pula o campo
 			continue
@@ -106,6 +108,34 @@ func buildFieldMapWithPrefix(typ reflect.Type, parentPath fieldPath) map[string]
 	return fieldMap
 }
 
+// structColumns retorna os nomes das colunas (tags db) de uma struct, na ordem dos campos.
+func structColumns(typ reflect.Type) []string {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var columns []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if field.Anonymous {
+			columns = append(columns, structColumns(field.Type)...)
+			continue
+		}
+
+		colName, _ := core.ParseTag(field.Tag.Get("db"))
+		if colName == "" || colName == "-" {
+			continue
+		}
+
+		columns = append(columns, colName)
+	}
+	return columns
+}
+
 // toSnakeCase converte CamelCase para snake_case.
 func toSnakeCase(s string) string {
 	var result strings.Builder
@@ -0,0 +1,164 @@
+package query
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Scope é um filtro reutilizável que recebe um builder e retorna outro.
+// Scopes são funções comuns, então podem ser nomeados e parametrizados:
+//
+//	func Active(b *query.Builder[User]) *query.Builder[User] {
+//	    return b.Where(UserFields.IsActive.Eq(true))
+//	}
+//
+//	func InRegion(region string) query.Scope[User] {
+//	    return func(b *query.Builder[User]) *query.Builder[User] {
+//	        return b.Where(UserFields.Region.Eq(region))
+//	    }
+//	}
+//
+//	users, err := genus.Table[User](db).Scopes(Active, InRegion("br")).Find(ctx)
+type Scope[T any] func(*Builder[T]) *Builder[T]
+
+// defaultScope é um scope global registrado para um modelo.
+// apply opera sobre builderState para que o registro não dependa de T.
+type defaultScope struct {
+	name  string
+	apply func(builderState) builderState
+}
+
+var (
+	defaultScopesMu sync.RWMutex
+	defaultScopes   = map[reflect.Type][]defaultScope{}
+)
+
+// AddDefaultScope registra um scope global para o modelo T.
+// Todo builder de T aplica os scopes globais automaticamente, na ordem de registro,
+// ao executar a query (Find, First, Count, Exists, Pluck, Scalar, ToSQL) ou ao ser
+// usado como subquery. Use Unscoped para ignorá-los.
+// Registrar outro scope com o mesmo nome substitui o anterior.
+//
+// Normalmente chamado na inicialização do pacote:
+//
+//	func init() {
+//	    query.AddDefaultScope[User]("active", func(b *query.Builder[User]) *query.Builder[User] {
+//	        return b.Where(UserFields.IsActive.Eq(true))
+//	    })
+//	}
+func AddDefaultScope[T any](name string, scope Scope[T]) {
+	model := modelType[T]()
+	entry := defaultScope{
+		name: name,
+		apply: func(s builderState) builderState {
+			return scope(&Builder[T]{builderState: s}).builderState
+		},
+	}
+
+	defaultScopesMu.Lock()
+	defer defaultScopesMu.Unlock()
+
+	scopes := defaultScopes[model]
+	for i, existing := range scopes {
+		if existing.name == name {
+			updated := make([]defaultScope, len(scopes))
+			copy(updated, scopes)
+			updated[i] = entry
+			defaultScopes[model] = updated
+			return
+		}
+	}
+	defaultScopes[model] = append(scopes[:len(scopes):len(scopes)], entry)
+}
+
+// RemoveDefaultScope remove o scope global com o nome informado do modelo T.
+func RemoveDefaultScope[T any](name string) {
+	model := modelType[T]()
+
+	defaultScopesMu.Lock()
+	defer defaultScopesMu.Unlock()
+
+	var remaining []defaultScope
+	for _, existing := range defaultScopes[model] {
+		if existing.name != name {
+			remaining = append(remaining, existing)
+		}
+	}
+	if len(remaining) == 0 {
+		delete(defaultScopes, model)
+		return
+	}
+	defaultScopes[model] = remaining
+}
+
+// modelType retorna o reflect.Type de T, usado como chave do registro de scopes.
+func modelType[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// Scopes aplica scopes ao builder, na ordem recebida.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) Scopes(scopes ...Scope[T]) *Builder[T] {
+	result := b.clone()
+	for _, scope := range scopes {
+		result = scope(result)
+	}
+	return result
+}
+
+// Unscoped desativa os scopes globais do modelo neste builder.
+// Sem argumentos ignora todos; com nomes ignora apenas os scopes informados.
+//
+// Exemplo:
+//
+//	all, err := genus.Table[User](db).Unscoped().Find(ctx)              // inclui inativos
+//	any, err := genus.Table[User](db).Unscoped("region").Find(ctx)      // mantém "active"
+//
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) Unscoped(names ...string) *Builder[T] {
+	newBuilder := b.clone()
+	if len(names) == 0 {
+		newBuilder.unscoped = true
+		return newBuilder
+	}
+	newBuilder.skipScopes = append(newBuilder.skipScopes, names...)
+	return newBuilder
+}
+
+// scoped retorna o builder com os scopes globais do modelo aplicados.
+// Chamado pelos pontos de execução; o resultado é marcado como unscoped
+// para que os scopes não sejam aplicados duas vezes.
+func (b *Builder[T]) scoped() *Builder[T] {
+	if b.unscoped || b.model == nil {
+		return b
+	}
+
+	defaultScopesMu.RLock()
+	scopes := defaultScopes[b.model]
+	defaultScopesMu.RUnlock()
+
+	if len(scopes) == 0 {
+		return b
+	}
+
+	result := b.clone()
+	result.unscoped = true
+	for _, scope := range scopes {
+		if containsName(b.skipScopes, scope.name) {
+			continue
+		}
+		result.builderState = scope.apply(result.builderState)
+	}
+	result.unscoped = true
+	return result
+}
+
+// containsName verifica se name está em names.
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GabrielOnRails/genus/core"
+)
+
+// TextSearch é uma busca full-text sobre uma ou mais colunas. Use Match para
+// filtrar e Rank para ordenar pelos resultados mais relevantes.
+//
+// O SQL gerado depende do dialeto:
+//   - PostgreSQL: to_tsvector(...) @@ plainto_tsquery(...), ordenado por ts_rank
+//   - MySQL: MATCH (...) AGAINST (...), que exige um índice FULLTEXT com as mesmas colunas
+//   - SQLite: MATCH na tabela virtual FTS5 "<tabela>_fts", criada pelo migrate
+//
+// Marque as colunas com a opção fulltext na tag db para que o migrate crie o
+// índice correspondente.
+//
+// Exemplo:
+//
+//	search := query.Search("genus orm", PostFields.Title, PostFields.Body)
+//	posts, err := genus.Table[Post](db).
+//	    Where(search.Match()).
+//	    OrderBy(search.Rank()).
+//	    Limit(20).
+//	    Find(ctx)
+type TextSearch struct {
+	text     string
+	columns  []string
+	language string
+}
+
+// Search cria uma busca pelas palavras de text nas colunas informadas.
+// Todas as palavras devem estar presentes (exceto no MySQL, que ordena por relevância).
+func Search(text string, fields ...Column) TextSearch {
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		columns[i] = field.ColumnName()
+	}
+	return TextSearch{text: text, columns: columns, language: core.DefaultFullTextLanguage}
+}
+
+// Language define a configuração de text search do PostgreSQL (ex: "english").
+// Deve ser a mesma da tag fulltext para que o índice GIN seja usado.
+// Ignorada nos demais dialetos.
+func (s TextSearch) Language(language string) TextSearch {
+	s.language = language
+	return s
+}
+
+// Match cria a condição "as colunas contêm as palavras buscadas".
+func (s TextSearch) Match() Condition {
+	return Condition{
+		Field:    strings.Join(s.columns, ", "),
+		Operator: OpMatch,
+		Value:    s,
+	}
+}
+
+// Rank cria uma ordenação pelos resultados mais relevantes primeiro.
+func (s TextSearch) Rank() OrderBy {
+	return OrderBy{search: &s}
+}
+
+// TSVector retorna a expressão to_tsvector do PostgreSQL para as colunas.
+// É a mesma expressão usada por Match e pelo índice GIN criado pelo migrate.
+func TSVector(language string, columns ...string) string {
+	parts := make([]string, len(columns))
+	for i, column := range columns {
+		parts[i] = fmt.Sprintf("coalesce(%s, '')", column)
+	}
+	return fmt.Sprintf("to_tsvector(%s, %s)", quoteLiteral(language), strings.Join(parts, " || ' ' || "))
+}
+
+// FTSTable retorna o nome da tabela virtual FTS5 usada no SQLite para a tabela.
+func FTSTable(tableName string) string {
+	return tableName + "_fts"
+}
+
+// buildMatch constrói o predicado de busca na sintaxe do dialeto.
+func (s TextSearch) buildMatch(dialect core.Dialect, tableName string, argIndex *int) (string, []interface{}) {
+	switch dialect.Name() {
+	case "postgres":
+		sql := fmt.Sprintf("%s @@ plainto_tsquery(%s, ?)", TSVector(s.language, s.columns...), quoteLiteral(s.language))
+		return rewritePlaceholders(sql, dialect, argIndex), []interface{}{s.text}
+
+	case "mysql":
+		sql := fmt.Sprintf("MATCH (%s) AGAINST (?)", strings.Join(s.columns, ", "))
+		return rewritePlaceholders(sql, dialect, argIndex), []interface{}{s.text}
+
+	default:
+		ftsQuery := s.fts5Query()
+		if ftsQuery == "" {
+			return "1 = 0", nil
+		}
+		fts := dialect.QuoteIdentifier(FTSTable(tableName))
+		sql := fmt.Sprintf("id IN (SELECT rowid FROM %s WHERE %s MATCH ?)", fts, fts)
+		return rewritePlaceholders(sql, dialect, argIndex), []interface{}{ftsQuery}
+	}
+}
+
+// buildRank constrói a expressão de ordenação por relevância, já com a direção.
+func (s TextSearch) buildRank(dialect core.Dialect, tableName string, argIndex *int) (string, []interface{}) {
+	switch dialect.Name() {
+	case "postgres":
+		sql := fmt.Sprintf("ts_rank(%s, plainto_tsquery(%s, ?)) DESC", TSVector(s.language, s.columns...), quoteLiteral(s.language))
+		return rewritePlaceholders(sql, dialect, argIndex), []interface{}{s.text}
+
+	case "mysql":
+		sql := fmt.Sprintf("MATCH (%s) AGAINST (?) DESC", strings.Join(s.columns, ", "))
+		return rewritePlaceholders(sql, dialect, argIndex), []interface{}{s.text}
+
+	default:
+		// Sem palavras não há relevância (e MATCH '' é um erro no FTS5):
+		// ordena por uma constante, como Match usa "1 = 0"
+		ftsQuery := s.fts5Query()
+		if ftsQuery == "" {
+			return "NULL", nil
+		}
+
+		// rank do FTS5 é menor para os resultados mais relevantes
+		fts := dialect.QuoteIdentifier(FTSTable(tableName))
+		sql := fmt.Sprintf("(SELECT rank FROM %s WHERE %s MATCH ? AND rowid = %s.id) ASC",
+			fts, fts, dialect.QuoteIdentifier(tableName))
+		return rewritePlaceholders(sql, dialect, argIndex), []interface{}{ftsQuery}
+	}
+}
+
+// fts5Query converte o texto em uma query FTS5 que exige todas as palavras,
+// tratando cada uma como literal (sem operadores como OR, NOT ou "*").
+func (s TextSearch) fts5Query() string {
+	words := strings.Fields(s.text)
+	if len(words) == 0 {
+		return ""
+	}
+
+	terms := make([]string, len(words))
+	for i, word := range words {
+		terms[i] = `"` + strings.ReplaceAll(word, `"`, `""`) + `"`
+	}
+
+	return fmt.Sprintf("{%s} : (%s)", strings.Join(s.columns, " "), strings.Join(terms, " "))
+}
+
+// quoteLiteral escapa s como um literal de string SQL.
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
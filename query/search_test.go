@@ -0,0 +1,56 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+)
+
+func TestSearchRankSQL(t *testing.T) {
+	f := sqlUserFields
+	search := Search("genus orm", f.Name)
+	empty := Search("  ", f.Name)
+
+	runSQLCases(t, []sqlCase{
+		{
+			name:     "postgres",
+			builder:  NewBuilder[sqlUser](nil, postgres.New(), nil, "users").Where(f.Age.Gt(18)).OrderBy(search.Rank(), f.ID.Asc()),
+			wantSQL:  `SELECT * FROM "users" WHERE age > $1 ORDER BY ts_rank(to_tsvector('simple', coalesce(name, '')), plainto_tsquery('simple', $2)) DESC, id ASC`,
+			wantArgs: []interface{}{18, "genus orm"},
+		},
+		{
+			name:     "sqlite",
+			builder:  NewBuilder[sqlUser](nil, sqlite.New(), nil, "users").OrderBy(search.Rank()),
+			wantSQL:  `SELECT * FROM "users" ORDER BY (SELECT rank FROM "users_fts" WHERE "users_fts" MATCH ? AND rowid = "users".id) ASC`,
+			wantArgs: []interface{}{`{name} : ("genus" "orm")`},
+		},
+		{
+			name:    "sqlite empty text",
+			builder: NewBuilder[sqlUser](nil, sqlite.New(), nil, "users").Where(empty.Match()).OrderBy(empty.Rank(), f.ID.Asc()),
+			wantSQL: `SELECT * FROM "users" WHERE 1 = 0 ORDER BY NULL, id ASC`,
+		},
+	})
+}
+
+func TestSearchRankEmptyTextSQLite(t *testing.T) {
+	sqlDB := openSQLite(t)
+	for _, name := range []string{"b", "a"} {
+		if _, err := sqlDB.Exec(`INSERT INTO users (name, age, created_at, updated_at) VALUES (?, 20, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`, name); err != nil {
+			t.Fatalf("Failed to insert user: %v", err)
+		}
+	}
+
+	// Sem palavras, o rank não consulta a tabela FTS5 (que nem existe aqui)
+	empty := Search("", sqlUserFields.Name)
+	users, err := NewBuilder[sqlUser](sqlDB, sqlite.New(), &queryLogger{}, "users").
+		OrderBy(empty.Rank(), sqlUserFields.Name.Asc()).
+		Find(context.Background())
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	if len(users) != 2 || users[0].Name != "a" || users[1].Name != "b" {
+		t.Errorf("Expected users ordered by name, got %+v", users)
+	}
+}
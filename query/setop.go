@@ -0,0 +1,151 @@
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/GabrielOnRails/genus/core"
+)
+
+// SetOperator representa um operador de conjunto entre queries.
+type SetOperator string
+
+const (
+	SetUnion     SetOperator = "UNION"
+	SetUnionAll  SetOperator = "UNION ALL"
+	SetIntersect SetOperator = "INTERSECT"
+	SetExcept    SetOperator = "EXCEPT"
+)
+
+// setOperationAlias é o alias da tabela derivada que contém o resultado combinado.
+const setOperationAlias = "combined"
+
+// compoundQuery é uma subquery formada por left <op> right.
+type compoundQuery struct {
+	dialect core.Dialect
+	left    Subquery
+	op      SetOperator
+	right   Subquery
+	columns []string
+}
+
+// Union combina os resultados das duas queries, removendo duplicatas.
+// Where, OrderBy, Limit e Offset chamados no builder retornado se aplicam
+// ao resultado combinado.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+//
+// Exemplo:
+//
+//	admins := genus.Table[User](db).Where(UserFields.IsAdmin.Eq(true))
+//	recent := genus.Table[User](db).OrderByDesc("created_at").Limit(10)
+//	users, err := admins.Union(recent).OrderBy(UserFields.Name.Asc()).Find(ctx)
+func (b *Builder[T]) Union(other *Builder[T]) *Builder[T] {
+	return b.combine(SetUnion, other)
+}
+
+// UnionAll combina os resultados das duas queries, mantendo duplicatas.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) UnionAll(other *Builder[T]) *Builder[T] {
+	return b.combine(SetUnionAll, other)
+}
+
+// Intersect retorna apenas as linhas presentes nas duas queries.
+// No MySQL, que só suporta INTERSECT a partir da 8.0.31, é emulado com JOIN.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) Intersect(other *Builder[T]) *Builder[T] {
+	return b.combine(SetIntersect, other)
+}
+
+// Except retorna as linhas da primeira query que não estão na segunda.
+// No MySQL, que só suporta EXCEPT a partir da 8.0.31, é emulado com NOT EXISTS.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) Except(other *Builder[T]) *Builder[T] {
+	return b.combine(SetExcept, other)
+}
+
+// combine cria um novo builder cuja fonte é o resultado de b <op> other.
+func (b *Builder[T]) combine(op SetOperator, other *Builder[T]) *Builder[T] {
+	columns := b.selectCols
+	if len(columns) == 0 {
+		var model T
+		columns = structColumns(reflect.TypeOf(model))
+	}
+
+	compound := compoundQuery{
+		dialect: b.dialect,
+		left:    b.clone(),
+		op:      op,
+		right:   other.clone(),
+		columns: columns,
+	}
+
+	// Os scopes globais já são aplicados a cada lado da operação; o cache e o
+	// resolver de tenant do builder valem para o resultado combinado
+	combined := NewBuilder[T](b.executor, b.dialect, b.logger, b.tableName).
+		UseCache(b.cache).
+		UseTenantResolver(b.tenantResolver).
+		Unscoped().
+		FromSubquery(compound, setOperationAlias)
+	combined.cacheTTL = b.cacheTTL
+	return combined
+}
+
+// applyTenant aplica o filtro de tenant aos dois lados da operação.
+func (c compoundQuery) applyTenant(filter *tenantFilter) Subquery {
+	c.left = c.left.applyTenant(filter)
+	c.right = c.right.applyTenant(filter)
+	return c
+}
+
+// buildErr retorna o primeiro erro de construção dos dois lados da operação.
+func (c compoundQuery) buildErr() error {
+	if err := c.left.buildErr(); err != nil {
+		return err
+	}
+	return c.right.buildErr()
+}
+
+// referencedTables retorna as tabelas lidas pelos dois lados da operação.
+func (c compoundQuery) referencedTables() []string {
+	return append(c.left.referencedTables(), c.right.referencedTables()...)
+}
+
+// buildSubquery constrói a operação de conjunto com a sintaxe de cada dialeto.
+func (c compoundQuery) buildSubquery(argIndex *int) (string, []interface{}) {
+	leftSQL, leftArgs := c.left.buildSubquery(argIndex)
+	rightSQL, rightArgs := c.right.buildSubquery(argIndex)
+	args := append(leftArgs, rightArgs...)
+
+	switch c.dialect.Name() {
+	case "mysql":
+		if c.op == SetIntersect || c.op == SetExcept {
+			return c.buildMySQLEmulation(leftSQL, rightSQL), args
+		}
+		return fmt.Sprintf("(%s) %s (%s)", leftSQL, c.op, rightSQL), args
+
+	case "sqlite":
+		// SQLite não aceita parênteses nos membros de uma operação de conjunto;
+		// envolve cada membro em uma subquery para preservar ORDER BY/LIMIT próprios.
+		return fmt.Sprintf("SELECT * FROM (%s) %s SELECT * FROM (%s)", leftSQL, c.op, rightSQL), args
+
+	default:
+		return fmt.Sprintf("(%s) %s (%s)", leftSQL, c.op, rightSQL), args
+	}
+}
+
+// buildMySQLEmulation emula INTERSECT e EXCEPT no MySQL comparando todas as colunas
+// com o operador null-safe <=>, preservando a semântica de conjuntos (NULL = NULL).
+func (c compoundQuery) buildMySQLEmulation(leftSQL, rightSQL string) string {
+	matches := make([]string, len(c.columns))
+	for i, col := range c.columns {
+		quoted := c.dialect.QuoteIdentifier(col)
+		matches[i] = fmt.Sprintf("l.%s <=> r.%s", quoted, quoted)
+	}
+	on := strings.Join(matches, " AND ")
+
+	if c.op == SetIntersect {
+		return fmt.Sprintf("SELECT DISTINCT l.* FROM (%s) AS l INNER JOIN (%s) AS r ON %s", leftSQL, rightSQL, on)
+	}
+	return fmt.Sprintf("SELECT DISTINCT l.* FROM (%s) AS l WHERE NOT EXISTS (SELECT 1 FROM (%s) AS r WHERE %s)", leftSQL, rightSQL, on)
+}
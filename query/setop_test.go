@@ -0,0 +1,26 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+)
+
+func TestSetOperationPlaceholderNumbering(t *testing.T) {
+	users := NewBuilder[sqlUser](nil, postgres.New(), nil, "users")
+	f := sqlUserFields
+
+	runSQLCases(t, []sqlCase{
+		{
+			name: "union then intersect",
+			builder: users.Where(f.Age.Gt(18)).
+				Union(users.Where(f.Age.Lt(5))).
+				Intersect(users.Where(f.Name.Eq("x"))).
+				Where(f.Age.Ne(3)).
+				Limit(10),
+			wantSQL: `SELECT * FROM ((SELECT * FROM ((SELECT * FROM "users" WHERE age > $1) UNION (SELECT * FROM "users" WHERE age < $2)) AS "combined") ` +
+				`INTERSECT (SELECT * FROM "users" WHERE name = $3)) AS "combined" WHERE age != $4 LIMIT 10`,
+			wantArgs: []interface{}{18, 5, "x", 3},
+		},
+	})
+}
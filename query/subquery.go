@@ -0,0 +1,150 @@
+package query
+
+import "fmt"
+
+// Subquery é uma query que pode ser usada dentro de outra query.
+// É implementada por *Builder[T] de qualquer T; o método não exportado
+// garante que apenas builders do Genus sejam aceitos.
+//
+// A numeração dos placeholders é contínua entre a query externa e as
+// subqueries, então $1, $2, ... ficam corretos no PostgreSQL.
+type Subquery interface {
+	buildSubquery(argIndex *int) (string, []interface{})
+	applyTenant(filter *tenantFilter) Subquery
+	buildErr() error
+	referencedTables() []string
+}
+
+// buildSubquery constrói o SELECT do builder continuando a numeração de argIndex.
+func (b *Builder[T]) buildSubquery(argIndex *int) (string, []interface{}) {
+	return b.scoped().buildSelect(argIndex)
+}
+
+// buildErr retorna o primeiro erro de construção do builder, de suas condições
+// (ex: valor inválido em JSONPath.Contains) ou de suas subqueries.
+// Os pontos de execução retornam esse erro em vez de executar a query.
+func (b *Builder[T]) buildErr() error {
+	if b.err != nil {
+		return b.err
+	}
+	for _, expr := range b.conditions {
+		if err := expressionErr(expr); err != nil {
+			return err
+		}
+	}
+	for _, c := range b.ctes {
+		if err := c.anchor.buildErr(); err != nil {
+			return err
+		}
+		if c.recursive != nil {
+			if err := c.recursive.buildErr(); err != nil {
+				return err
+			}
+		}
+	}
+	if b.fromSubquery != nil {
+		return b.fromSubquery.buildErr()
+	}
+	return nil
+}
+
+// expressionErr retorna o primeiro erro de construção de uma expressão.
+func expressionErr(expr Expression) error {
+	switch e := expr.(type) {
+	case Condition:
+		switch value := e.Value.(type) {
+		case jsonPredicate:
+			return value.err
+		case Subquery:
+			return value.buildErr()
+		}
+	case ConditionGroup:
+		for _, c := range e.Conditions {
+			if err := expressionErr(c); err != nil {
+				return err
+			}
+		}
+	case NotExpression:
+		return expressionErr(e.Expression)
+	}
+	return nil
+}
+
+// Exists cria uma condição EXISTS (subquery).
+//
+// Exemplo:
+//
+//	genus.Table[User](db).Where(query.Exists(
+//	    genus.Table[Order](db).Select("1").Where(OrderFields.Status.Eq("paid")),
+//	))
+func Exists(sub Subquery) Condition {
+	return Condition{
+		Operator: OpExists,
+		Value:    sub,
+	}
+}
+
+// NotExists cria uma condição NOT EXISTS (subquery).
+func NotExists(sub Subquery) Condition {
+	return Condition{
+		Operator: OpNotExists,
+		Value:    sub,
+	}
+}
+
+// CompareSubquery compara um campo com o resultado de uma subquery escalar.
+// A subquery deve retornar uma única linha e uma única coluna.
+// op deve ser OpEq, OpNe, OpGt, OpGte, OpLt ou OpLte; qualquer outro operador
+// faz a query retornar erro ao ser executada (use InSubquery, Exists ou NotExists).
+//
+// Exemplo:
+//
+//	// WHERE age > (SELECT AVG(age) FROM "users")
+//	avgAge := genus.Table[User](db).Select("AVG(age)")
+//	genus.Table[User](db).Where(query.CompareSubquery(UserFields.Age, query.OpGt, avgAge))
+func CompareSubquery(field Column, op Operator, sub Subquery) Condition {
+	switch op {
+	case OpEq, OpNe, OpGt, OpGte, OpLt, OpLte:
+	default:
+		sub = invalidSubquery{
+			Subquery: sub,
+			err:      fmt.Errorf("operator %s is not supported by CompareSubquery", op),
+		}
+	}
+
+	return Condition{
+		Field:    field.ColumnName(),
+		Operator: op,
+		Value:    sub,
+	}
+}
+
+// invalidSubquery guarda o erro de uma comparação inválida com subquery.
+// buildErr retorna o erro, então a query nunca é construída nem executada.
+type invalidSubquery struct {
+	Subquery
+	err error
+}
+
+func (s invalidSubquery) buildErr() error {
+	return s.err
+}
+
+func (s invalidSubquery) applyTenant(filter *tenantFilter) Subquery {
+	return invalidSubquery{Subquery: s.Subquery.applyTenant(filter), err: s.err}
+}
+
+// FromSubquery usa uma subquery como fonte da query: FROM (subquery) AS alias.
+// As colunas da subquery devem corresponder às tags db de T.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+//
+// Exemplo:
+//
+//	recent := genus.Table[Order](db).Where(OrderFields.Total.Gt(100)).OrderByDesc("id").Limit(50)
+//	orders, err := genus.Table[Order](db).FromSubquery(recent, "recent_orders").Find(ctx)
+func (b *Builder[T]) FromSubquery(sub Subquery, alias string) *Builder[T] {
+	newBuilder := b.clone()
+	newBuilder.fromSubquery = sub
+	newBuilder.fromAlias = alias
+	return newBuilder
+}
@@ -0,0 +1,68 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+)
+
+func TestSubqueryPlaceholderNumbering(t *testing.T) {
+	users := NewBuilder[sqlUser](nil, postgres.New(), nil, "users")
+	orders := NewBuilder[sqlUser](nil, postgres.New(), nil, "orders")
+	f := sqlUserFields
+
+	runSQLCases(t, []sqlCase{
+		{
+			name: "in subquery",
+			builder: users.
+				Where(f.Age.Gt(18)).
+				Where(f.ID.InSubquery(orders.Select("user_id").Where(f.Total.Gt(100)).Where(f.Name.Ne("x")))).
+				Where(f.Name.Eq("a")),
+			wantSQL:  `SELECT * FROM "users" WHERE age > $1 AND id IN (SELECT user_id FROM "orders" WHERE total > $2 AND name != $3) AND name = $4`,
+			wantArgs: []interface{}{18, 100, "x", "a"},
+		},
+		{
+			name:     "exists",
+			builder:  users.Where(f.Age.Gt(18)).Where(NotExists(orders.Where(f.Total.Gt(100)))),
+			wantSQL:  `SELECT * FROM "users" WHERE age > $1 AND NOT EXISTS (SELECT * FROM "orders" WHERE total > $2)`,
+			wantArgs: []interface{}{18, 100},
+		},
+		{
+			name:     "from subquery",
+			builder:  users.FromSubquery(orders.Where(f.Total.Gt(100)), "big").Where(f.Age.Lt(30)),
+			wantSQL:  `SELECT * FROM (SELECT * FROM "orders" WHERE total > $1) AS "big" WHERE age < $2`,
+			wantArgs: []interface{}{100, 30},
+		},
+	})
+}
+
+func TestCompareSubqueryOperators(t *testing.T) {
+	users := NewBuilder[sqlUser](nil, postgres.New(), nil, "users")
+	avgAge := users.Select("AVG(age)").Where(sqlUserFields.Age.Gt(0))
+
+	for _, op := range []Operator{OpEq, OpNe, OpGt, OpGte, OpLt, OpLte} {
+		t.Run(string(op), func(t *testing.T) {
+			sql, args, err := users.Where(CompareSubquery(sqlUserFields.Age, op, avgAge)).ToSQL()
+			if err != nil {
+				t.Fatalf("ToSQL failed: %v", err)
+			}
+			want := `SELECT * FROM "users" WHERE age ` + string(op) + ` (SELECT AVG(age) FROM "users" WHERE age > $1)`
+			if sql != want {
+				t.Errorf("Unexpected SQL:\n got: %s\nwant: %s", sql, want)
+			}
+			if len(args) != 1 || args[0] != 0 {
+				t.Errorf("Unexpected args: %#v", args)
+			}
+		})
+	}
+
+	for _, op := range []Operator{OpLike, OpIn, OpBetween, OpIsNull, OpExists, OpExpr} {
+		t.Run(string(op), func(t *testing.T) {
+			// O erro também é encontrado dentro de grupos e negações
+			cond := Not(Or(sqlUserFields.Age.Eq(1), CompareSubquery(sqlUserFields.Age, op, avgAge)))
+			if _, _, err := users.Where(cond).ToSQL(); err == nil {
+				t.Errorf("Expected operator %s to be rejected", op)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package query
+
+import (
+	"context"
+
+	"github.com/GabrielOnRails/genus/core"
+)
+
+// tenantFilter é o tenant aplicado a uma query. err é core.ErrMissingTenant
+// quando o modo multi-tenant está ativo e o contexto não tem tenant.
+type tenantFilter struct {
+	value interface{}
+	err   error
+}
+
+// ForTenant filtra a query pelo tenant informado, em vez do tenant do contexto.
+// Útil em ToSQL, que não recebe contexto, e em jobs que processam um tenant específico.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) ForTenant(tenant interface{}) *Builder[T] {
+	newBuilder := b.clone()
+	newBuilder.tenant = &tenantFilter{value: tenant}
+	return newBuilder
+}
+
+// UseTenantResolver ativa o modo multi-tenant no builder: o tenant é resolvido
+// do contexto de execução. genus.Table já usa o resolver do DB
+// (ver core.DB.SetTenantResolver); use com builders criados por NewBuilder.
+// IMUTÁVEL: Retorna um novo builder sem modificar o original.
+func (b *Builder[T]) UseTenantResolver(resolver core.TenantResolver) *Builder[T] {
+	newBuilder := b.clone()
+	newBuilder.tenantResolver = resolver
+	return newBuilder
+}
+
+// forContext prepara o builder para execução: aplica os scopes globais e
+// o filtro de tenant resolvido de ctx (ver UseTenantResolver). O mesmo
+// filtro vale para todas as subqueries.
+func (b *Builder[T]) forContext(ctx context.Context) *Builder[T] {
+	var filter *tenantFilter
+	if tenant, enabled, err := b.tenantResolver.CurrentTenant(ctx); enabled {
+		filter = &tenantFilter{value: tenant, err: err}
+	}
+	return b.withTenant(filter)
+}
+
+// withTenant aplica o filtro de tenant ao builder e a todas as suas subqueries.
+// Um tenant definido com ForTenant tem precedência sobre filter.
+func (b *Builder[T]) withTenant(filter *tenantFilter) *Builder[T] {
+	if b.tenantApplied {
+		return b
+	}
+
+	result := b.scoped().clone()
+	result.tenantApplied = true
+	if result.tenant != nil {
+		filter = result.tenant
+	}
+
+	for i, expr := range result.conditions {
+		result.conditions[i] = tenantExpression(expr, filter)
+	}
+	for i, c := range result.ctes {
+		result.ctes[i].anchor = c.anchor.applyTenant(filter)
+		if c.recursive != nil {
+			result.ctes[i].recursive = c.recursive.applyTenant(filter)
+		}
+	}
+	if result.fromSubquery != nil {
+		result.fromSubquery = result.fromSubquery.applyTenant(filter)
+		return result
+	}
+
+	if filter == nil || result.model == nil || !core.IsTenantModel(result.model) || result.isCTE(result.tableName) {
+		return result
+	}
+	if filter.err != nil {
+		if result.err == nil {
+			result.err = filter.err
+		}
+		return result
+	}
+
+	// Usa o mesmo valor convertido que Create grava na coluna do tenant
+	value, err := core.TenantValue(result.model, filter.value)
+	if err != nil {
+		if result.err == nil {
+			result.err = err
+		}
+		return result
+	}
+
+	result.conditions = append(result.conditions, Condition{
+		Field:    result.dialect.QuoteIdentifier(result.tableName) + "." + core.TenantColumn,
+		Operator: OpEq,
+		Value:    value,
+	})
+	return result
+}
+
+// applyTenant implementa Subquery.
+func (b *Builder[T]) applyTenant(filter *tenantFilter) Subquery {
+	return b.withTenant(filter)
+}
+
+// isCTE indica se name é uma CTE do builder. Consultas a uma CTE não recebem
+// o filtro de tenant: as queries da própria CTE já são filtradas.
+func (s *builderState) isCTE(name string) bool {
+	for _, c := range s.ctes {
+		if c.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// tenantExpression aplica o filtro de tenant às subqueries de uma expressão.
+func tenantExpression(expr Expression, filter *tenantFilter) Expression {
+	switch e := expr.(type) {
+	case Condition:
+		if sub, ok := e.Value.(Subquery); ok {
+			e.Value = sub.applyTenant(filter)
+		}
+		return e
+	case ConditionGroup:
+		conditions := make([]Expression, len(e.Conditions))
+		for i, c := range e.Conditions {
+			conditions[i] = tenantExpression(c, filter)
+		}
+		e.Conditions = conditions
+		return e
+	case NotExpression:
+		e.Expression = tenantExpression(e.Expression, filter)
+		return e
+	}
+	return expr
+}
@@ -0,0 +1,160 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+)
+
+// tenantAccount e tenantOrder são modelos multi-tenant; tenantCountry não é.
+type tenantAccount struct {
+	core.Model
+	TenantID int64 `db:"tenant_id"`
+}
+
+type tenantOrder struct {
+	core.Model
+	TenantID  int64 `db:"tenant_id"`
+	AccountID int64 `db:"account_id"`
+	Total     int   `db:"total"`
+}
+
+type tenantCountry struct {
+	core.Model
+	Name string `db:"name"`
+}
+
+var (
+	tenantOrderAccountID = NewInt64Field("account_id")
+	tenantOrderTotal     = NewIntField("total")
+)
+
+// tenantBuilder cria um builder com o modo multi-tenant ativo.
+func tenantBuilder[T any](tableName string) *Builder[T] {
+	return NewBuilder[T](nil, postgres.New(), nil, tableName).UseTenantResolver(core.TenantFromContext)
+}
+
+func TestTenantFilterPropagation(t *testing.T) {
+	t.Parallel()
+
+	orders := tenantBuilder[tenantOrder]("orders")
+	accounts := tenantBuilder[tenantAccount]("accounts")
+
+	tests := []struct {
+		name     string
+		builder  *Builder[tenantOrder]
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "main query",
+			builder:  orders.Where(tenantOrderTotal.Gt(10)).ForTenant(7),
+			wantSQL:  `SELECT * FROM "orders" WHERE total > $1 AND "orders".tenant_id = $2`,
+			wantArgs: []interface{}{10, int64(7)},
+		},
+		{
+			name: "subquery",
+			builder: orders.
+				Where(tenantOrderTotal.Gt(10)).
+				Where(tenantOrderAccountID.InSubquery(accounts.Select("id"))).
+				ForTenant(7),
+			wantSQL:  `SELECT * FROM "orders" WHERE total > $1 AND account_id IN (SELECT id FROM "accounts" WHERE "accounts".tenant_id = $2) AND "orders".tenant_id = $3`,
+			wantArgs: []interface{}{10, int64(7), int64(7)},
+		},
+		{
+			name:     "cte",
+			builder:  orders.With("big", orders.Where(tenantOrderTotal.Gt(10))).From("big").ForTenant(7),
+			wantSQL:  `WITH "big" AS (SELECT * FROM "orders" WHERE total > $1 AND "orders".tenant_id = $2) SELECT * FROM "big"`,
+			wantArgs: []interface{}{10, int64(7)},
+		},
+		{
+			name:     "union",
+			builder:  orders.Where(tenantOrderTotal.Gt(10)).Union(orders.Where(tenantOrderTotal.Lt(5))).ForTenant(7),
+			wantSQL:  `SELECT * FROM ((SELECT * FROM "orders" WHERE total > $1 AND "orders".tenant_id = $2) UNION (SELECT * FROM "orders" WHERE total < $3 AND "orders".tenant_id = $4)) AS "combined"`,
+			wantArgs: []interface{}{10, int64(7), 5, int64(7)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sql, args, err := tt.builder.ToSQL()
+			if err != nil {
+				t.Fatalf("ToSQL failed: %v", err)
+			}
+			if sql != tt.wantSQL {
+				t.Errorf("Unexpected SQL:\n got: %s\nwant: %s", sql, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("Unexpected args: got %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestTenantMissingInSubquery(t *testing.T) {
+	t.Parallel()
+
+	orders := tenantBuilder[tenantOrder]("orders")
+	countries := tenantBuilder[tenantCountry]("countries")
+
+	// A query externa não é multi-tenant, mas a subquery é: sem tenant, a
+	// subquery não pode ser executada sem filtro
+	_, _, err := countries.Where(Exists(orders.Select("1"))).ToSQL()
+	if !errors.Is(err, core.ErrMissingTenant) {
+		t.Errorf("Expected ErrMissingTenant from subquery, got %v", err)
+	}
+
+	_, _, err = orders.Union(orders).ToSQL()
+	if !errors.Is(err, core.ErrMissingTenant) {
+		t.Errorf("Expected ErrMissingTenant from union, got %v", err)
+	}
+
+	if _, _, err := countries.ToSQL(); err != nil {
+		t.Errorf("Expected non-tenant model to build without tenant, got %v", err)
+	}
+}
+
+func TestTenantFilterUsesColumnType(t *testing.T) {
+	t.Parallel()
+
+	orders := tenantBuilder[tenantOrder]("orders")
+
+	// O filtro usa o mesmo valor que Create grava: int -> int64
+	_, args, err := orders.ForTenant(7).ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL failed: %v", err)
+	}
+	if !reflect.DeepEqual(args, []interface{}{int64(7)}) {
+		t.Errorf("Expected int64 tenant arg, got %#v", args)
+	}
+
+	if _, _, err := orders.ForTenant("7").ToSQL(); err == nil {
+		t.Error("Expected string tenant to be rejected for an int64 tenant column")
+	}
+}
+
+func TestTenantResolverIsPerBuilder(t *testing.T) {
+	t.Parallel()
+
+	// Sem resolver o builder não é multi-tenant, mesmo com um tenant no contexto
+	ctx := core.WithTenant(context.Background(), int64(7))
+	plain := NewBuilder[tenantOrder](nil, postgres.New(), nil, "orders").forContext(ctx)
+	if len(plain.conditions) != 0 || plain.err != nil {
+		t.Errorf("Expected no tenant filter without resolver, got %v (err %v)", plain.conditions, plain.err)
+	}
+
+	filtered := tenantBuilder[tenantOrder]("orders").forContext(ctx)
+	sql, args := filtered.buildSelectQuery()
+	if want := `SELECT * FROM "orders" WHERE "orders".tenant_id = $1`; sql != want {
+		t.Errorf("Unexpected SQL:\n got: %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{int64(7)}) {
+		t.Errorf("Unexpected args: %#v", args)
+	}
+}
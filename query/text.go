@@ -0,0 +1,115 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GabrielOnRails/genus/core"
+)
+
+// likeEscape é o caractere de escape usado por StartsWith, EndsWith e Contains.
+// "!" evita as diferenças de tratamento de "\" em literais entre os bancos.
+const likeEscape = "!"
+
+// ILike cria a condição "coluna ILIKE pattern" (LIKE sem diferenciar maiúsculas).
+// No PostgreSQL usa ILIKE; nos demais, LOWER(coluna) LIKE LOWER(pattern).
+func (f StringField) ILike(pattern string) Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpILike,
+		Value:    pattern,
+	}
+}
+
+// NotILike cria a condição "coluna NOT ILIKE pattern".
+func (f StringField) NotILike(pattern string) Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpNotILike,
+		Value:    pattern,
+	}
+}
+
+// StartsWith cria a condição "coluna começa com prefix".
+// "%" e "_" em prefix são escapados e comparados literalmente.
+func (f StringField) StartsWith(prefix string) Condition {
+	return f.likeEscaped(escapeLike(prefix) + "%")
+}
+
+// EndsWith cria a condição "coluna termina com suffix".
+// "%" e "_" em suffix são escapados e comparados literalmente.
+func (f StringField) EndsWith(suffix string) Condition {
+	return f.likeEscaped("%" + escapeLike(suffix))
+}
+
+// Contains cria a condição "coluna contém substr".
+// "%" e "_" em substr são escapados e comparados literalmente.
+func (f StringField) Contains(substr string) Condition {
+	return f.likeEscaped("%" + escapeLike(substr) + "%")
+}
+
+// Regexp cria a condição "coluna corresponde à expressão regular pattern".
+// Usa ~ no PostgreSQL e REGEXP no MySQL e SQLite. No SQLite, a conexão deve ser
+// aberta com o driver sqlite.DriverName, que registra a função REGEXP.
+//
+// A sintaxe e a sensibilidade a maiúsculas seguem o banco: POSIX no PostgreSQL,
+// ICU no MySQL 8 (sem diferenciar maiúsculas em collations _ci) e RE2 no SQLite.
+func (f StringField) Regexp(pattern string) Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpRegexp,
+		Value:    pattern,
+	}
+}
+
+// NotRegexp cria a condição "coluna não corresponde à expressão regular pattern".
+func (f StringField) NotRegexp(pattern string) Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpNotRegexp,
+		Value:    pattern,
+	}
+}
+
+// likeEscaped cria a condição "coluna LIKE pattern ESCAPE '!'".
+func (f StringField) likeEscaped(pattern string) Condition {
+	return Expr(fmt.Sprintf("%s LIKE ? ESCAPE '%s'", f.column, likeEscape), pattern)
+}
+
+// escapeLike escapa os curingas de LIKE ("%" e "_") e o próprio caractere de escape.
+func escapeLike(s string) string {
+	s = strings.ReplaceAll(s, likeEscape, likeEscape+likeEscape)
+	s = strings.ReplaceAll(s, "%", likeEscape+"%")
+	return strings.ReplaceAll(s, "_", likeEscape+"_")
+}
+
+// buildTextCondition constrói ILIKE e REGEXP na sintaxe do dialeto.
+func buildTextCondition(dialect core.Dialect, cond Condition, argIndex *int) (string, []interface{}) {
+	placeholder := dialect.Placeholder(*argIndex)
+	*argIndex++
+	args := []interface{}{cond.Value}
+
+	postgres := dialect.Name() == "postgres"
+
+	switch cond.Operator {
+	case OpILike, OpNotILike:
+		if postgres {
+			return fmt.Sprintf("%s %s %s", cond.Field, cond.Operator, placeholder), args
+		}
+		op := OpLike
+		if cond.Operator == OpNotILike {
+			op = OpNotLike
+		}
+		return fmt.Sprintf("LOWER(%s) %s LOWER(%s)", cond.Field, op, placeholder), args
+
+	default:
+		op := string(cond.Operator)
+		if postgres {
+			op = "~"
+			if cond.Operator == OpNotRegexp {
+				op = "!~"
+			}
+		}
+		return fmt.Sprintf("%s %s %s", cond.Field, op, placeholder), args
+	}
+}
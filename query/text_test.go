@@ -0,0 +1,43 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/mysql"
+	"github.com/GabrielOnRails/genus/dialects/postgres"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+)
+
+func TestTextMatchingPerDialect(t *testing.T) {
+	f := sqlUserFields
+	args := []interface{}{"%a%", "b%", "^a", "z$"}
+	build := func(dialect core.Dialect) *Builder[sqlUser] {
+		return NewBuilder[sqlUser](nil, dialect, nil, "users").
+			Where(f.Name.ILike("%a%")).
+			Where(f.Name.NotILike("b%")).
+			Where(f.Name.Regexp("^a")).
+			Where(f.Name.NotRegexp("z$"))
+	}
+
+	runSQLCases(t, []sqlCase{
+		{
+			name:     "postgres",
+			builder:  build(postgres.New()),
+			wantSQL:  `SELECT * FROM "users" WHERE name ILIKE $1 AND name NOT ILIKE $2 AND name ~ $3 AND name !~ $4`,
+			wantArgs: args,
+		},
+		{
+			name:     "mysql",
+			builder:  build(mysql.New()),
+			wantSQL:  "SELECT * FROM `users` WHERE LOWER(name) LIKE LOWER(?) AND LOWER(name) NOT LIKE LOWER(?) AND name REGEXP ? AND name NOT REGEXP ?",
+			wantArgs: args,
+		},
+		{
+			name:     "sqlite",
+			builder:  build(sqlite.New()),
+			wantSQL:  `SELECT * FROM "users" WHERE LOWER(name) LIKE LOWER(?) AND LOWER(name) NOT LIKE LOWER(?) AND name REGEXP ? AND name NOT REGEXP ?`,
+			wantArgs: args,
+		},
+	})
+}
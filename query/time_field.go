@@ -0,0 +1,120 @@
+package query
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeField representa um campo time.Time com operadores de comparação de datas.
+type TimeField struct {
+	Field[time.Time]
+}
+
+func NewTimeField(column string) TimeField {
+	return TimeField{NewField[time.Time](column)}
+}
+
+// Col retorna a coluna como operando, para comparações com outras colunas.
+func (f TimeField) Col() OrderedOperand[time.Time] {
+	return orderedColumnOperand[time.Time](f.column)
+}
+
+func (f TimeField) Gt(value time.Time) Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpGt,
+		Value:    value,
+	}
+}
+
+func (f TimeField) Gte(value time.Time) Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpGte,
+		Value:    value,
+	}
+}
+
+func (f TimeField) Lt(value time.Time) Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpLt,
+		Value:    value,
+	}
+}
+
+func (f TimeField) Lte(value time.Time) Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpLte,
+		Value:    value,
+	}
+}
+
+func (f TimeField) Between(start, end time.Time) Condition {
+	return Condition{
+		Field:    f.column,
+		Operator: OpBetween,
+		Value:    []interface{}{start, end},
+	}
+}
+
+// Before cria a condição "coluna < t".
+func (f TimeField) Before(t time.Time) Condition {
+	return f.Lt(t)
+}
+
+// After cria a condição "coluna > t".
+func (f TimeField) After(t time.Time) Condition {
+	return f.Gt(t)
+}
+
+// OnDate cria a condição "coluna no mesmo dia de t" (no fuso de t).
+// Gera um intervalo [início do dia, início do dia seguinte), que usa índices.
+func (f TimeField) OnDate(t time.Time) Condition {
+	start := truncateDay(t)
+	return f.inRange(start, start.AddDate(0, 0, 1))
+}
+
+// InMonth cria a condição "coluna no mesmo mês de t" (no fuso de t).
+func (f TimeField) InMonth(t time.Time) Condition {
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	return f.inRange(start, start.AddDate(0, 1, 0))
+}
+
+// InYear cria a condição "coluna no mesmo ano de t" (no fuso de t).
+func (f TimeField) InYear(t time.Time) Condition {
+	start := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	return f.inRange(start, start.AddDate(1, 0, 0))
+}
+
+// WithinLast cria a condição "coluna >= agora - d".
+// O instante é calculado quando a condição é criada, não quando a query é executada.
+func (f TimeField) WithinLast(d time.Duration) Condition {
+	return f.Gte(time.Now().Add(-d))
+}
+
+// OlderThan cria a condição "coluna < agora - d".
+// O instante é calculado quando a condição é criada, não quando a query é executada.
+func (f TimeField) OlderThan(d time.Duration) Condition {
+	return f.Lt(time.Now().Add(-d))
+}
+
+// inRange cria a condição "start <= coluna < end".
+func (f TimeField) inRange(start, end time.Time) Condition {
+	return Expr(fmt.Sprintf("%s >= ? AND %s < ?", f.column, f.column), start, end)
+}
+
+// OptionalTimeField representa um campo core.Optional[time.Time] (nullable).
+type OptionalTimeField struct {
+	TimeField
+}
+
+func NewOptionalTimeField(column string) OptionalTimeField {
+	return OptionalTimeField{NewTimeField(column)}
+}
+
+// truncateDay retorna o início do dia de t, no fuso de t.
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,160 @@
+package genus
+
+import (
+	"context"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/query"
+)
+
+// RepositoryInterface é a forma de interface de Repository[T].
+// Útil para criar mocks em testes de serviços que dependem de um repositório.
+// Como nas funções CRUD, PT é *T e T precisa implementar core.Identifiable.
+type RepositoryInterface[T any, PT ModelPtr[T]] interface {
+	FindByID(ctx context.Context, id int64) (T, error)
+	FindAll(ctx context.Context) ([]T, error)
+	FindWhere(ctx context.Context, conditions ...query.Expression) ([]T, error)
+	Create(ctx context.Context, model *T) error
+	Update(ctx context.Context, model *T) error
+	Delete(ctx context.Context, model *T) error
+	Exists(ctx context.Context, conditions ...query.Expression) (bool, error)
+	Count(ctx context.Context, conditions ...query.Expression) (int64, error)
+	Paginate(ctx context.Context, page, pageSize int, conditions ...query.Expression) (Page[T], error)
+	PaginateBy(ctx context.Context, page, pageSize int, orders []query.OrderBy, conditions ...query.Expression) (Page[T], error)
+}
+
+// Repository é um repositório genérico com as operações comuns para o modelo T.
+// Pode ser embutido em repositórios de domínio, que adicionam apenas os métodos customizados:
+//
+//	type UserRepository struct {
+//	    *genus.Repository[User, *User]
+//	}
+//
+//	func (r *UserRepository) FindByEmail(ctx context.Context, email string) (User, error) {
+//	    return r.Query().Where(UserFields.Email.Eq(email)).First(ctx)
+//	}
+//
+// Assim como nas funções CRUD, o modelo deve implementar core.Identifiable:
+// Repository[int, *int] não compila.
+type Repository[T any, PT ModelPtr[T]] struct {
+	g *Genus
+}
+
+// Garante em tempo de compilação que Repository implementa RepositoryInterface.
+var _ RepositoryInterface[core.Model, *core.Model] = (*Repository[core.Model, *core.Model])(nil)
+
+// NewRepository cria um novo repositório para o modelo T.
+// PT é inferido de T: genus.NewRepository[User](g) retorna *Repository[User, *User].
+func NewRepository[T any, PT ModelPtr[T]](g *Genus) *Repository[T, PT] {
+	return &Repository[T, PT]{g: g}
+}
+
+// Page representa uma página de resultados.
+type Page[T any] struct {
+	Items      []T
+	Total      int64
+	Page       int
+	PageSize   int
+	TotalPages int
+}
+
+// Query retorna um novo query builder para o modelo T.
+// Use para consultas customizadas nos repositórios de domínio.
+func (r *Repository[T, PT]) Query() *query.Builder[T] {
+	return Table[T](r.g)
+}
+
+// FindByID busca um registro pelo ID.
+func (r *Repository[T, PT]) FindByID(ctx context.Context, id int64) (T, error) {
+	return r.Query().Where(idField.Eq(id)).First(ctx)
+}
+
+// FindAll retorna todos os registros.
+func (r *Repository[T, PT]) FindAll(ctx context.Context) ([]T, error) {
+	return r.Query().Find(ctx)
+}
+
+// FindWhere retorna os registros que satisfazem todas as condições.
+func (r *Repository[T, PT]) FindWhere(ctx context.Context, conditions ...query.Expression) ([]T, error) {
+	return r.where(conditions).Find(ctx)
+}
+
+// Create insere um novo registro.
+func (r *Repository[T, PT]) Create(ctx context.Context, model *T) error {
+	return r.g.db.Create(ctx, model)
+}
+
+// Update atualiza um registro existente.
+func (r *Repository[T, PT]) Update(ctx context.Context, model *T) error {
+	return r.g.db.Update(ctx, model)
+}
+
+// Delete remove um registro.
+func (r *Repository[T, PT]) Delete(ctx context.Context, model *T) error {
+	return r.g.db.Delete(ctx, model)
+}
+
+// Exists retorna true se existe pelo menos um registro que satisfaz as condições.
+func (r *Repository[T, PT]) Exists(ctx context.Context, conditions ...query.Expression) (bool, error) {
+	return r.where(conditions).Exists(ctx)
+}
+
+// Count retorna a quantidade de registros que satisfazem as condições.
+func (r *Repository[T, PT]) Count(ctx context.Context, conditions ...query.Expression) (int64, error) {
+	return r.where(conditions).Count(ctx)
+}
+
+// Paginate retorna a página informada (começando em 1) dos registros que satisfazem as condições,
+// ordenados por id para que as páginas sejam estáveis.
+func (r *Repository[T, PT]) Paginate(ctx context.Context, page, pageSize int, conditions ...query.Expression) (Page[T], error) {
+	return r.PaginateBy(ctx, page, pageSize, nil, conditions...)
+}
+
+// PaginateBy é como Paginate, mas ordena pelas colunas informadas.
+// Sem ordenação, usa ORDER BY id: sem ORDER BY o banco pode repetir ou pular
+// registros entre páginas.
+//
+// Exemplo:
+//
+//	page, err := repo.PaginateBy(ctx, 2, 20, []query.OrderBy{UserFields.Name.Asc(), UserFields.ID.Asc()})
+func (r *Repository[T, PT]) PaginateBy(ctx context.Context, page, pageSize int, orders []query.OrderBy, conditions ...query.Expression) (Page[T], error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	builder := r.where(conditions)
+
+	total, err := builder.Count(ctx)
+	if err != nil {
+		return Page[T]{}, err
+	}
+
+	if len(orders) == 0 {
+		orders = []query.OrderBy{idField.Asc()}
+	}
+
+	items, err := builder.OrderBy(orders...).Limit(pageSize).Offset((page - 1) * pageSize).Find(ctx)
+	if err != nil {
+		return Page[T]{}, err
+	}
+
+	return Page[T]{
+		Items:      items,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// where aplica as condições a um novo query builder.
+func (r *Repository[T, PT]) where(conditions []query.Expression) *query.Builder[T] {
+	builder := r.Query()
+	for _, cond := range conditions {
+		builder = builder.Where(cond)
+	}
+	return builder
+}
@@ -0,0 +1,123 @@
+package genus
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GabrielOnRails/genus/core"
+	"github.com/GabrielOnRails/genus/dialects/sqlite"
+	"github.com/GabrielOnRails/genus/query"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Item é o modelo usado nos testes do repositório.
+type Item struct {
+	core.Model
+	Name string `db:"name"`
+}
+
+// queryLogger guarda as queries executadas.
+type queryLogger struct {
+	queries []string
+}
+
+func (l *queryLogger) LogQuery(query string, args []interface{}, duration int64) {
+	l.queries = append(l.queries, query)
+}
+
+func (l *queryLogger) LogError(query string, args []interface{}, err error) {
+	l.queries = append(l.queries, query)
+}
+
+// setupItems cria um banco SQLite em memória com os itens informados.
+func setupItems(t *testing.T, names ...string) (*Repository[Item, *Item], *queryLogger) {
+	t.Helper()
+
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if _, err := sqlDB.Exec(`CREATE TABLE item (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT, created_at DATETIME, updated_at DATETIME)`); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+
+	logger := &queryLogger{}
+	repo := NewRepository[Item](NewWithLogger(sqlDB, sqlite.New(), logger))
+	for _, name := range names {
+		if err := repo.Create(context.Background(), &Item{Name: name}); err != nil {
+			t.Fatalf("Failed to create item: %v", err)
+		}
+	}
+	logger.queries = nil
+
+	return repo, logger
+}
+
+// itemNames retorna os nomes dos itens da página.
+func itemNames(page Page[Item]) []string {
+	names := make([]string, len(page.Items))
+	for i, item := range page.Items {
+		names[i] = item.Name
+	}
+	return names
+}
+
+func TestPaginateOrdersByID(t *testing.T) {
+	repo, logger := setupItems(t, "c", "a", "e", "b", "d")
+	ctx := context.Background()
+
+	first, err := repo.Paginate(ctx, 1, 2)
+	if err != nil {
+		t.Fatalf("Paginate failed: %v", err)
+	}
+	second, err := repo.Paginate(ctx, 2, 2)
+	if err != nil {
+		t.Fatalf("Paginate failed: %v", err)
+	}
+
+	if got := itemNames(first); !reflect.DeepEqual(got, []string{"c", "a"}) {
+		t.Errorf("Unexpected first page: %v", got)
+	}
+	if got := itemNames(second); !reflect.DeepEqual(got, []string{"e", "b"}) {
+		t.Errorf("Unexpected second page: %v", got)
+	}
+	if second.Total != 5 || second.TotalPages != 3 {
+		t.Errorf("Expected 5 items in 3 pages, got %d in %d", second.Total, second.TotalPages)
+	}
+
+	pages := 0
+	for _, q := range logger.queries {
+		if strings.HasPrefix(q, "SELECT *") {
+			pages++
+			if !strings.Contains(q, "ORDER BY id ASC LIMIT 2") {
+				t.Errorf("Expected page query ordered by id, got %s", q)
+			}
+		}
+	}
+	if pages != 2 {
+		t.Errorf("Expected 2 page queries, got %v", logger.queries)
+	}
+}
+
+func TestPaginateByCustomOrder(t *testing.T) {
+	repo, _ := setupItems(t, "c", "a", "e", "b", "d")
+	name := query.NewStringField("name")
+
+	second, err := repo.PaginateBy(context.Background(), 2, 2, []query.OrderBy{name.Desc()}, name.Ne("e"))
+	if err != nil {
+		t.Fatalf("PaginateBy failed: %v", err)
+	}
+
+	if got := itemNames(second); !reflect.DeepEqual(got, []string{"b", "a"}) {
+		t.Errorf("Unexpected second page: %v", got)
+	}
+	if second.Total != 4 || second.TotalPages != 2 {
+		t.Errorf("Expected 4 items in 2 pages, got %d in %d", second.Total, second.TotalPages)
+	}
+}